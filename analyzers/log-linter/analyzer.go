@@ -1,15 +1,234 @@
 package analyzer
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
 	"golang.org/x/tools/go/analysis"
-	"golang.org/x/tools/go/analysis/passes/inspect"
 )
 
 // Config содержит настройки линтера.
 type Config struct {
-	// SensitivePatterns — подстроки имён переменных,
-	// указывающие на потенциально чувствительные данные.
+	// SensitivePatterns — паттерны имён переменных, полей и ключей,
+	// указывающих на потенциально чувствительные данные: слова ("auth"
+	// совпадает с authToken, но не с author), glob ("*_key") или
+	// регулярные выражения ("/^x-.*-token$/").
+	//
+	// По умолчанию паттерны дополняют встроенные (см. PatternsMode).
 	SensitivePatterns []string
+	// PatternsMode определяет, как SensitivePatterns сочетаются со
+	// встроенными паттернами: дополняют их (PatternsExtend, по умолчанию)
+	// или заменяют (PatternsReplace).
+	PatternsMode PatternsMode
+	// ExcludePatterns — встроенные паттерны, которые не нужно применять
+	// в режиме PatternsExtend.
+	ExcludePatterns []string
+	// AllowPatterns — паттерны имён, которые никогда не считаются
+	// чувствительными, даже если совпадают с SensitivePatterns.
+	// Синтаксис тот же.
+	AllowPatterns []string
+	// SensitiveTag — тег поля структуры в синтаксисе Go, помечающий поле
+	// как секретное: структуры с такими полями нельзя передавать логгеру
+	// целиком. По умолчанию — log:"secret".
+	SensitiveTag string
+	// Loggers — дополнительные логгеры, вызовы которых подлежат проверке.
+	// Дополняют встроенные (slog, zap, logrus, log) и перекрывают их при
+	// совпадении пакета, типа и метода.
+	Loggers []LoggerSpec
+	// Taint включает отслеживание секретных данных по SSA: значение,
+	// полученное из источника (параметра, поля или глобальной переменной
+	// с чувствительным именем, функции из TaintSources), сообщается,
+	// когда доходит до логгера через промежуточные переменные.
+	Taint bool
+	// TaintSources — дополнительные функции-источники секретных данных
+	// для режима Taint. Дополняют встроенные (os.Getenv и os.LookupEnv
+	// с чувствительным именем переменной окружения).
+	TaintSources []TaintSource
+	// PII включает поиск персональных данных: адресов электронной почты,
+	// номеров телефонов, IBAN и номеров карт в строковых константах,
+	// а также переменных, полей и ключей с именами вроде email и phone.
+	// Включает правило RulePII, если оно не настроено в Rules явно.
+	PII bool
+	// PIIPatterns — дополнительные паттерны имён персональных данных.
+	// Дополняют встроенные (email, phone, ssn, passport и др.); синтаксис
+	// тот же, что у SensitivePatterns, AllowPatterns применяются и к ним.
+	PIIPatterns []string
+	// AllowedPunctuation — знаки, которые, помимо латинских букв, цифр
+	// и пробела, допустимы в сообщениях, например ":.-()". Учитываются
+	// и в исправлениях.
+	AllowedPunctuation string
+	// AllowedScripts — письменности Unicode, буквы которых допустимы
+	// в сообщениях: имена из unicode.Scripts ("Cyrillic") или диапазоны
+	// кодовых точек ("U+0400-U+04FF", "U+00E9").
+	AllowedScripts []string
+	// MessagePattern — регулярное выражение, которому должно
+	// соответствовать каждое константное сообщение (правило
+	// RuleMessagePattern). Пустое значение выключает проверку.
+	MessagePattern string
+	// Rules — настройки отдельных правил по их идентификаторам
+	// (RuleLowercase, RuleSensitiveData и т.д.): включение, выключение
+	// и уровень диагностик.
+	Rules map[string]RuleConfig
+	// Overrides — настройки для отдельных пакетов и файлов: правила,
+	// допустимые символы и паттерны имён, которые отличаются от общих.
+	Overrides []Override
+	// Debug включает вывод итоговой конфигурации (списков паттернов)
+	// в stderr перед анализом.
+	Debug bool
+
+	// names — разобранные SensitivePatterns и AllowPatterns.
+	names *nameMatcher
+	// piiNames — разобранные паттерны персональных данных и AllowPatterns.
+	piiNames *nameMatcher
+	// charset — разобранные AllowedPunctuation и AllowedScripts.
+	charset *charset
+	// messagePattern — скомпилированный MessagePattern.
+	messagePattern *regexp.Regexp
+}
+
+// LoggerSpec описывает методы логгера, подлежащие проверке.
+type LoggerSpec struct {
+	// Package — путь пакета, в котором объявлен логгер.
+	Package string
+	// Receiver — имя типа, у которого вызываются методы. Пустое значение
+	// означает функции пакета и методы любых его типов; запись типа
+	// дополняет запись пакета и перекрывает её для своих методов.
+	Receiver string
+	// Methods — имена методов логирования.
+	Methods []string
+	// MessageIndex — индекс аргумента-сообщения; -1 означает, что
+	// сообщения нет (With и подобные методы, прикрепляющие поля).
+	MessageIndex int
+	// KeyValueIndex — индекс первого аргумента ключ-значение; значение,
+	// не превышающее MessageIndex, означает, что их нет.
+	KeyValueIndex int
+	// Format — сообщение является printf-подобной строкой формата
+	// (Printf, Infof и т.п.).
+	Format bool
+}
+
+// PatternsMode — способ сочетания пользовательских паттернов
+// со встроенными.
+type PatternsMode string
+
+const (
+	// PatternsExtend — пользовательские паттерны дополняют встроенные.
+	PatternsExtend PatternsMode = "extend"
+	// PatternsReplace — пользовательские паттерны заменяют встроенные.
+	PatternsReplace PatternsMode = "replace"
+)
+
+// TaintSource описывает функции, результаты которых считаются секретными
+// в режиме Taint.
+type TaintSource struct {
+	// Package — путь пакета, в котором объявлены функции.
+	Package string
+	// Receiver — имя типа, методами которого являются функции; пустое
+	// значение означает функции пакета.
+	Receiver string
+	// Functions — имена функций или методов.
+	Functions []string
+	// Args — glob-шаблоны (синтаксис path.Match, без учёта регистра) для
+	// первого константного строкового аргумента, например "*_SECRET" для
+	// os.Getenv. Пустой список означает, что источником является любой вызов.
+	Args []string
+}
+
+// Validate проверяет корректность конфигурации.
+func (c Config) Validate() error {
+	var errs []error
+	switch c.PatternsMode {
+	case "", PatternsExtend:
+		for _, p := range c.ExcludePatterns {
+			if !slices.Contains(defaultSensitivePatterns, p) {
+				errs = append(errs, fmt.Errorf("exclude pattern %q is not a default pattern", p))
+			}
+		}
+	case PatternsReplace:
+		if len(c.SensitivePatterns) == 0 {
+			errs = append(errs, errors.New("patterns mode replace requires sensitive patterns"))
+		}
+		if len(c.ExcludePatterns) > 0 {
+			errs = append(errs, errors.New("exclude patterns have no effect in patterns mode replace"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("unknown patterns mode %q", c.PatternsMode))
+	}
+	if _, err := newNameMatcher(c.SensitivePatterns, c.AllowPatterns); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := newNameMatcher(c.PIIPatterns, nil); err != nil {
+		errs = append(errs, fmt.Errorf("pii: %w", err))
+	}
+	if c.SensitiveTag != "" {
+		if _, _, err := parseSensitiveTag(c.SensitiveTag); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if _, err := newCharset(c.AllowedPunctuation, c.AllowedScripts); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := regexp.Compile(c.MessagePattern); err != nil {
+		errs = append(errs, fmt.Errorf("message pattern: %w", err))
+	}
+	if err := c.validateRules(); err != nil {
+		errs = append(errs, err)
+	}
+	for i, spec := range c.Loggers {
+		if err := spec.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("loggers[%d]: %w", i, err))
+		}
+	}
+	for i, src := range c.TaintSources {
+		if err := src.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("taintSources[%d]: %w", i, err))
+		}
+	}
+	for i, o := range c.Overrides {
+		if err := o.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("overrides[%d]: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s LoggerSpec) validate() error {
+	switch {
+	case s.Package == "":
+		return errors.New("package is required")
+	case len(s.Methods) == 0:
+		return errors.New("at least one method is required")
+	case s.MessageIndex < -1:
+		return fmt.Errorf("message index %d is invalid", s.MessageIndex)
+	case s.KeyValueIndex < 0:
+		return fmt.Errorf("key-value index %d is negative", s.KeyValueIndex)
+	case s.KeyValueIndex != 0 && s.KeyValueIndex <= s.MessageIndex:
+		return fmt.Errorf("key-value index %d must follow message index %d", s.KeyValueIndex, s.MessageIndex)
+	}
+	return nil
+}
+
+func (s TaintSource) validate() error {
+	switch {
+	case s.Package == "":
+		return errors.New("package is required")
+	case len(s.Functions) == 0:
+		return errors.New("at least one function is required")
+	}
+	for _, arg := range s.Args {
+		if _, err := path.Match(arg, ""); err != nil {
+			return fmt.Errorf("argument pattern %q: %w", arg, err)
+		}
+	}
+	return nil
 }
 
 // defaultSensitivePatterns — паттерны по умолчанию.
@@ -24,18 +243,224 @@ var defaultSensitivePatterns = []string{
 	"private",
 }
 
+// defaultSensitiveTag — тег секретного поля по умолчанию.
+const defaultSensitiveTag = `log:"secret"`
+
+// New возвращает анализатор loglinter, применяющий все включённые
+// правила. Ошибку проверки конфигурации (см. Config.Validate) анализатор
+// возвращает при первом запуске.
 func New(cfgs ...Config) *analysis.Analyzer {
-	cfg := Config{
-		SensitivePatterns: defaultSensitivePatterns,
+	l := newLinter(cfgs)
+	a := &analysis.Analyzer{
+		Name: "loglinter",
+		Doc:  "loglinter checks for common logging issues",
+		Run: func(pass *analysis.Pass) (any, error) {
+			calls, err := l.calls(pass)
+			if err != nil {
+				return nil, err
+			}
+			used := make(map[string]usedIgnores)
+			for _, r := range rules {
+				if !l.ruleEnabled(r.id) {
+					continue
+				}
+				if r.id == RuleIgnoreDirective {
+					calls = calls.withUsed(used)
+				}
+				used[r.id] = l.runRule(pass, r, calls)
+			}
+			return nil, nil
+		},
+		Requires: []*analysis.Analyzer{l.callsAnalyzer},
+	}
+	l.addFlags(a)
+	return a
+}
+
+// NewAnalyzers возвращает по анализатору на каждое правило, включённое
+// в конфигурации или хотя бы в одном из её переопределений.
+// Анализатор ignore_directive зависит от остальных: их результаты —
+// сработавшие директивы подавления — нужны ему, чтобы сообщить
+// о неиспользованных. Флаг show-config есть только у первого анализатора.
+// Все они используют общий анализатор вызовов логгеров, так что вызовы
+// собираются один раз. Имя анализатора — идентификатор правила, в котором
+// дефисы заменены подчёркиваниями (sensitive_data).
+func NewAnalyzers(cfgs ...Config) []*analysis.Analyzer {
+	l := newLinter(cfgs)
+	var analyzers []*analysis.Analyzer
+	var ids []string
+	for _, r := range rules {
+		if !l.ruleEnabled(r.id) {
+			continue
+		}
+		a := &analysis.Analyzer{
+			Name:       r.analyzerName(),
+			Doc:        r.doc,
+			Requires:   []*analysis.Analyzer{l.callsAnalyzer},
+			ResultType: reflect.TypeFor[usedIgnores](),
+		}
+		if r.id == RuleIgnoreDirective {
+			others, otherIDs := slices.Clone(analyzers), slices.Clone(ids)
+			a.Requires = append(a.Requires, others...)
+			a.Run = func(pass *analysis.Pass) (any, error) {
+				calls, err := l.calls(pass)
+				if err != nil {
+					return nil, err
+				}
+				used := make(map[string]usedIgnores)
+				for i, other := range others {
+					used[otherIDs[i]] = pass.ResultOf[other].(usedIgnores)
+				}
+				return l.runRule(pass, r, calls.withUsed(used)), nil
+			}
+		} else {
+			a.Run = func(pass *analysis.Pass) (any, error) {
+				calls, err := l.calls(pass)
+				if err != nil {
+					return nil, err
+				}
+				return l.runRule(pass, r, calls), nil
+			}
+		}
+		if len(analyzers) == 0 {
+			l.addFlags(a)
+		}
+		analyzers = append(analyzers, a)
+		ids = append(ids, r.id)
+	}
+	return analyzers
+}
+
+// linter — общее состояние анализаторов одной конфигурации.
+type linter struct {
+	cfg Config
+	// err — ошибка проверки конфигурации (см. Config.Validate);
+	// сообщается при анализе.
+	err error
+	// callsAnalyzer собирает вызовы логгеров для всех правил.
+	callsAnalyzer *analysis.Analyzer
+	debugOnce     sync.Once
+
+	mu sync.Mutex
+	// configs — конфигурации с применёнными переопределениями по набору
+	// их индексов (см. overrideConfig).
+	configs map[string]Config
+}
+
+func newLinter(cfgs []Config) *linter {
+	var raw Config
+	if len(cfgs) > 0 {
+		raw = cfgs[0]
 	}
-	if len(cfgs) > 0 && len(cfgs[0].SensitivePatterns) > 0 {
-		cfg.SensitivePatterns = cfgs[0].SensitivePatterns
+	cfg := raw
+	cfg.SensitivePatterns = cfg.effectivePatterns()
+	if cfg.SensitiveTag == "" {
+		cfg.SensitiveTag = defaultSensitiveTag
 	}
 
-	return &analysis.Analyzer{
-		Name:     "loglinter",
-		Doc:      "loglinter checks for common logging issues",
-		Run:      makeRun(cfg),
-		Requires: []*analysis.Analyzer{inspect.Analyzer},
+	l := &linter{
+		callsAnalyzer: newCallsAnalyzer(newRegistry(append(slices.Clone(defaultLoggers), cfg.Loggers...))),
+	}
+	var err error
+	l.cfg, err = cfg.compile()
+	// Validate ловит и ошибки, не мешающие компиляции: неизвестные
+	// правила, неверные шаблоны переопределений и т.п.
+	if l.err = raw.Validate(); l.err == nil {
+		l.err = err
+	}
+	return l
+}
+
+// ruleEnabled сообщает, включено ли правило в конфигурации или хотя бы
+// в одном из переопределений.
+func (l *linter) ruleEnabled(id string) bool {
+	if l.cfg.ruleEnabled(id) {
+		return true
 	}
+	return slices.ContainsFunc(l.cfg.Overrides, func(o Override) bool {
+		return l.cfg.withOverride(o).ruleEnabled(id)
+	})
+}
+
+// addFlags добавляет анализатору флаги конфигурации.
+func (l *linter) addFlags(a *analysis.Analyzer) {
+	a.Flags.BoolVar(&l.cfg.Debug, "show-config", l.cfg.Debug, "print the effective sensitive patterns")
+}
+
+// calls возвращает вызовы логгеров, собранные для пакета, или ошибку
+// конфигурации. При включённом Debug один раз выводит конфигурацию.
+func (l *linter) calls(pass *analysis.Pass) (*logCalls, error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+	if l.cfg.Debug {
+		l.debugOnce.Do(func() { l.cfg.describe(os.Stderr) })
+	}
+	return pass.ResultOf[l.callsAnalyzer].(*logCalls), nil
+}
+
+// runRule применяет правило r к вызовам calls с конфигурацией,
+// действующей в их файлах, и возвращает директивы подавления, которые
+// подавили его диагностики.
+func (l *linter) runRule(pass *analysis.Pass, r rule, calls *logCalls) usedIgnores {
+	used := make(usedIgnores)
+	for _, s := range l.scopes(pass, calls) {
+		if s.cfg.ruleEnabled(r.id) {
+			r.run(s.cfg.rulePass(pass, r.id, s.calls.ignores, used), s.calls, s.cfg)
+		}
+	}
+	return used
+}
+
+// compile разбирает паттерны имён, допустимые символы и шаблон
+// сообщений и проверяет тег секретных полей.
+func (c Config) compile() (Config, error) {
+	names, err := newNameMatcher(c.SensitivePatterns, c.AllowPatterns)
+	piiNames, piiErr := newNameMatcher(c.piiPatterns(), c.AllowPatterns)
+	piiNames.noHeaders = true
+	_, _, tagErr := parseSensitiveTag(c.SensitiveTag)
+	cs, csErr := newCharset(c.AllowedPunctuation, c.AllowedScripts)
+	c.names, c.piiNames, c.charset = names, piiNames, cs
+
+	var reErr error
+	if c.MessagePattern != "" {
+		c.messagePattern, reErr = regexp.Compile(c.MessagePattern)
+	}
+	return c, errors.Join(err, piiErr, tagErr, csErr, reErr)
+}
+
+// effectivePatterns возвращает итоговый список чувствительных паттернов
+// с учётом PatternsMode и ExcludePatterns.
+func (c Config) effectivePatterns() []string {
+	if c.PatternsMode == PatternsReplace {
+		return slices.Clone(c.SensitivePatterns)
+	}
+
+	var patterns []string
+	for _, p := range append(slices.Clone(defaultSensitivePatterns), c.SensitivePatterns...) {
+		if !slices.Contains(c.ExcludePatterns, p) && !slices.Contains(patterns, p) {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns
+}
+
+// describe выводит итоговую конфигурацию для отладки.
+func (c Config) describe(w io.Writer) {
+	fmt.Fprintf(w, "loglinter: sensitive patterns: %s\n", strings.Join(c.SensitivePatterns, ", "))
+	fmt.Fprintf(w, "loglinter: allow patterns: %s\n", strings.Join(c.AllowPatterns, ", "))
+	fmt.Fprintf(w, "loglinter: sensitive tag: %s\n", c.SensitiveTag)
+	if c.PII {
+		fmt.Fprintf(w, "loglinter: pii patterns: %s\n", strings.Join(c.piiPatterns(), ", "))
+	}
+	for i, o := range c.Overrides {
+		fmt.Fprintf(w, "loglinter: overrides[%d]: packages: %s; files: %s; sensitive patterns: %s\n",
+			i, strings.Join(o.Packages, ", "), strings.Join(o.Files, ", "), strings.Join(o.SensitivePatterns, ", "))
+	}
+}
+
+// piiPatterns возвращает встроенные паттерны персональных данных,
+// дополненные PIIPatterns.
+func (c Config) piiPatterns() []string {
+	return append(slices.Clone(defaultPIIPatterns), c.PIIPatterns...)
 }
@@ -1,11 +1,465 @@
 package analyzer
 
 import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
 
+	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/analysistest"
 )
 
 func TestAnalyzer(t *testing.T) {
 	analysistest.Run(t, analysistest.TestData(), New())
 }
+
+func TestAnalyzerCustomLoggers(t *testing.T) {
+	cfg := Config{
+		Loggers: []LoggerSpec{
+			{
+				Package:       "testdata/registry/logx",
+				Methods:       []string{"Notice"},
+				KeyValueIndex: 1,
+			},
+			{
+				Package:       "testdata/registry/logx",
+				Receiver:      "Logger",
+				Methods:       []string{"Event"},
+				MessageIndex:  1,
+				KeyValueIndex: 2,
+			},
+		},
+	}
+	analysistest.Run(t, analysistest.TestData(), New(cfg), "./registry")
+}
+
+func TestAnalyzerReceiverLoggers(t *testing.T) {
+	// A receiver entry extends the package entry instead of replacing it.
+	cfg := Config{
+		Loggers: []LoggerSpec{
+			{Package: "go.uber.org/zap", Receiver: "Logger", Methods: []string{"Named"}},
+		},
+	}
+	analysistest.Run(t, analysistest.TestData(), New(cfg), "./registry/receiver")
+}
+
+func TestAnalyzerWrappers(t *testing.T) {
+	// Wrapper facts are exported by the shared calls analyzer, diagnostics
+	// by the rules, so they are checked separately.
+	calls := newCallsAnalyzer(newRegistry(defaultLoggers))
+	analysistest.Run(t, analysistest.TestData(), calls, "./wrappers/logging")
+	analysistest.Run(t, analysistest.TestData(), New(), "./wrappers")
+}
+
+func TestNewAnalyzers(t *testing.T) {
+	var names []string
+	for _, a := range NewAnalyzers() {
+		names = append(names, a.Name)
+	}
+	want := []string{"lowercase", "latin_only", "special_symbols", "sensitive_data", "hardcoded_secret", "format_args", "ignore_directive"}
+	if !slices.Equal(names, want) {
+		t.Errorf("NewAnalyzers() = %q, want %q", names, want)
+	}
+	for i, a := range NewAnalyzers() {
+		if hasFlag := a.Flags.Lookup("show-config") != nil; hasFlag != (i == 0) {
+			t.Errorf("analyzer %s has show-config flag = %v, want %v", a.Name, hasFlag, i == 0)
+		}
+	}
+
+	off := false
+	analyzers := NewAnalyzers(Config{PII: true, Rules: map[string]RuleConfig{RuleLatinOnly: {Enabled: &off}}})
+	if err := analysis.Validate(analyzers); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	names = names[:0]
+	for _, a := range analyzers {
+		names = append(names, a.Name)
+	}
+	want = []string{"lowercase", "special_symbols", "sensitive_data", "hardcoded_secret", "pii", "format_args", "ignore_directive"}
+	if !slices.Equal(names, want) {
+		t.Errorf("NewAnalyzers() = %q, want %q", names, want)
+	}
+}
+
+func TestIgnoreDirectiveAnalyzer(t *testing.T) {
+	// Unused directives are reported by the ignore_directive analyzer only,
+	// from the results of the rule analyzers it requires.
+	analyzers := map[string]*analysis.Analyzer{}
+	for _, a := range NewAnalyzers() {
+		analyzers[a.Name] = a
+	}
+	analysistest.Run(t, analysistest.TestData(), analyzers["ignore_directive"], "./ignore/unused")
+}
+
+func TestRuleAnalyzer(t *testing.T) {
+	// A single rule analyzer reports only its own diagnostics.
+	for _, a := range NewAnalyzers() {
+		if a.Name == "special_symbols" {
+			analysistest.Run(t, analysistest.TestData(), a, "./rules/symbols")
+			return
+		}
+	}
+	t.Fatal("special_symbols analyzer not found")
+}
+
+func TestAnalyzerTaint(t *testing.T) {
+	cfg := Config{
+		Taint: true,
+		TaintSources: []TaintSource{
+			{Package: "testdata/taint", Functions: []string{"readVault"}, Args: []string{"secret/*"}},
+		},
+	}
+	results := analysistest.Run(t, analysistest.TestData(), New(cfg), "./taint")
+
+	// The first diagnostic carries the flow from cfg.Token to the logger.
+	for _, res := range results {
+		for _, d := range res.Diagnostics {
+			if !strings.Contains(d.Message, "reaches logger") {
+				continue
+			}
+			var got []string
+			for _, r := range d.Related {
+				got = append(got, r.Message)
+			}
+			want := []string{"sensitive data originates here", "concatenated here"}
+			if !slices.Equal(got, want) {
+				t.Errorf("related = %q, want %q", got, want)
+			}
+			return
+		}
+	}
+	t.Error("no taint diagnostics reported")
+}
+
+func TestAnalyzerPII(t *testing.T) {
+	results := analysistest.Run(t, analysistest.TestData(), New(Config{PII: true}), "./pii")
+
+	for _, res := range results {
+		for _, d := range res.Diagnostics {
+			if d.Category != RulePII {
+				t.Errorf("diagnostic %q has category %q, want %q", d.Message, d.Category, RulePII)
+			}
+		}
+	}
+}
+
+func TestAnalyzerRules(t *testing.T) {
+	off, on := false, true
+	cfg := Config{
+		Rules: map[string]RuleConfig{
+			RuleLatinOnly:      {Enabled: &off},
+			RuleSpecialSymbols: {Severity: SeverityWarning},
+			RuleSensitiveData:  {Severity: SeverityError},
+			RulePII:            {Enabled: &on},
+		},
+	}
+	analysistest.Run(t, analysistest.TestData(), New(cfg), "./rules")
+}
+
+func TestAnalyzerIgnore(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), New(), "./ignore", "./ignore/file")
+}
+
+func TestAnalyzerOverrides(t *testing.T) {
+	off, on := false, true
+	cfg := Config{
+		Overrides: []Override{
+			{
+				Packages:           []string{"testdata/overrides/cmd/..."},
+				AllowedPunctuation: ":!",
+				Rules:              map[string]RuleConfig{RuleLowercase: {Enabled: &off}},
+			},
+			{
+				Packages:          []string{"testdata/overrides/internal/auth"},
+				SensitivePatterns: []string{"session"},
+				Rules: map[string]RuleConfig{
+					RuleSensitiveData: {Severity: SeverityError},
+					RulePII:           {Enabled: &on},
+				},
+			},
+			{
+				Files:          []string{"app/banner.go"},
+				AllowedScripts: []string{"Cyrillic"},
+			},
+		},
+	}
+	analysistest.Run(t, analysistest.TestData(), New(cfg),
+		"./overrides/cmd/tool", "./overrides/internal/auth", "./overrides/app")
+
+	// An override that enables a rule adds its analyzer.
+	var names []string
+	for _, a := range NewAnalyzers(cfg) {
+		names = append(names, a.Name)
+	}
+	if !slices.Contains(names, "pii") {
+		t.Errorf("NewAnalyzers() = %q, want pii analyzer", names)
+	}
+}
+
+func TestAnalyzerOverridesTaint(t *testing.T) {
+	// Both files share one SSA build but use their own patterns.
+	cfg := Config{
+		Taint: true,
+		Overrides: []Override{
+			{Files: []string{"flows/session.go"}, SensitivePatterns: []string{"session"}},
+		},
+	}
+	analysistest.Run(t, analysistest.TestData(), New(cfg), "./overrides/flows")
+}
+
+func TestAnalyzerCharset(t *testing.T) {
+	cfg := Config{
+		AllowedPunctuation: ":.-()",
+		AllowedScripts:     []string{"Cyrillic"},
+		MessagePattern:     `[^.]$`,
+	}
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), New(cfg), "./charset")
+}
+
+func TestAnalyzerSuggestedFixes(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), New(), "./fixes")
+}
+
+// errorRecorder collects the errors analysistest reports.
+type errorRecorder struct{ errs []string }
+
+func (r *errorRecorder) Errorf(format string, args ...any) {
+	r.errs = append(r.errs, fmt.Sprintf(format, args...))
+}
+
+func TestAnalyzerInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		want string
+	}{
+		{name: "unknown rule", cfg: Config{Rules: map[string]RuleConfig{"charset": {}}}, want: `unknown rule "charset"`},
+		{
+			name: "override pattern",
+			cfg:  Config{Overrides: []Override{{Packages: []string{"app/["}}}},
+			want: `overrides[0]: package pattern "app/["`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := newLinter([]Config{tt.cfg}).err; err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("newLinter() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+
+	// The error is returned from Run of both the monolithic analyzer and
+	// the per-rule ones.
+	cfg := tests[0].cfg
+	for _, a := range append([]*analysis.Analyzer{New(cfg)}, NewAnalyzers(cfg)[0]) {
+		var r errorRecorder
+		analysistest.Run(&r, analysistest.TestData(), a, "./rules")
+		if !slices.ContainsFunc(r.errs, func(e string) bool { return strings.Contains(e, `unknown rule "charset"`) }) {
+			t.Errorf("%s: errors = %q, want unknown rule", a.Name, r.errs)
+		}
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    LoggerSpec
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			spec: LoggerSpec{Package: "example.com/log", Methods: []string{"Info"}, KeyValueIndex: 1},
+		},
+		{
+			name:    "missing package",
+			spec:    LoggerSpec{Methods: []string{"Info"}},
+			wantErr: true,
+		},
+		{
+			name:    "missing methods",
+			spec:    LoggerSpec{Package: "example.com/log"},
+			wantErr: true,
+		},
+		{
+			name: "fields without message",
+			spec: LoggerSpec{Package: "example.com/log", Methods: []string{"With"}, MessageIndex: -1},
+		},
+		{
+			name:    "negative message index",
+			spec:    LoggerSpec{Package: "example.com/log", Methods: []string{"Info"}, MessageIndex: -2},
+			wantErr: true,
+		},
+		{
+			name:    "negative key-value index",
+			spec:    LoggerSpec{Package: "example.com/log", Methods: []string{"Info"}, KeyValueIndex: -1},
+			wantErr: true,
+		},
+		{
+			name:    "key-values before message",
+			spec:    LoggerSpec{Package: "example.com/log", Methods: []string{"Info"}, MessageIndex: 2, KeyValueIndex: 1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Config{Loggers: []LoggerSpec{tt.spec}}.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigValidateSensitiveTag(t *testing.T) {
+	if err := (Config{SensitiveTag: `mask:"true"`}).Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+	if err := (Config{SensitiveTag: "mask"}).Validate(); err == nil {
+		t.Error("Validate() error = nil, want error for malformed tag")
+	}
+}
+
+func TestConfigValidateTaintSources(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     TaintSource
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			src:  TaintSource{Package: "example.com/vault", Functions: []string{"Read"}, Args: []string{"secret/*"}},
+		},
+		{
+			name:    "missing package",
+			src:     TaintSource{Functions: []string{"Read"}},
+			wantErr: true,
+		},
+		{
+			name:    "missing functions",
+			src:     TaintSource{Package: "example.com/vault"},
+			wantErr: true,
+		},
+		{
+			name:    "malformed argument pattern",
+			src:     TaintSource{Package: "example.com/vault", Functions: []string{"Read"}, Args: []string{"[secret"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Config{TaintSources: []TaintSource{tt.src}}.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigValidatePatterns(t *testing.T) {
+	if err := (Config{SensitivePatterns: []string{"token", "*_key", "/^pw$/"}, AllowPatterns: []string{"page_token"}}).Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+	if err := (Config{SensitivePatterns: []string{"/(/"}}).Validate(); err == nil {
+		t.Error("Validate() error = nil, want error for malformed regexp")
+	}
+	if err := (Config{AllowPatterns: []string{"[page"}}).Validate(); err == nil {
+		t.Error("Validate() error = nil, want error for malformed glob")
+	}
+	if err := (Config{PII: true, PIIPatterns: []string{"/(/"}}).Validate(); err == nil {
+		t.Error("Validate() error = nil, want error for malformed pii pattern")
+	}
+	if err := (Config{AllowedScripts: []string{"Klingon"}}).Validate(); err == nil {
+		t.Error("Validate() error = nil, want error for unknown script")
+	}
+	if err := (Config{MessagePattern: "("}).Validate(); err == nil {
+		t.Error("Validate() error = nil, want error for malformed message pattern")
+	}
+}
+
+func TestConfigValidatePatternsMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{name: "extend by default", cfg: Config{SensitivePatterns: []string{"pin"}}},
+		{name: "exclude default", cfg: Config{ExcludePatterns: []string{"auth"}}},
+		{name: "exclude unknown", cfg: Config{ExcludePatterns: []string{"pin"}}, wantErr: true},
+		{name: "replace", cfg: Config{PatternsMode: PatternsReplace, SensitivePatterns: []string{"pin"}}},
+		{name: "replace without patterns", cfg: Config{PatternsMode: PatternsReplace}, wantErr: true},
+		{
+			name:    "replace with exclude",
+			cfg:     Config{PatternsMode: PatternsReplace, SensitivePatterns: []string{"pin"}, ExcludePatterns: []string{"auth"}},
+			wantErr: true,
+		},
+		{name: "unknown mode", cfg: Config{PatternsMode: "merge"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEffectivePatterns(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		want []string
+	}{
+		{
+			name: "defaults",
+			want: defaultSensitivePatterns,
+		},
+		{
+			name: "extend",
+			cfg:  Config{SensitivePatterns: []string{"pin", "token"}},
+			want: append(slices.Clone(defaultSensitivePatterns), "pin"),
+		},
+		{
+			name: "extend with exclude",
+			cfg:  Config{SensitivePatterns: []string{"pin"}, ExcludePatterns: []string{"auth", "private"}},
+			want: []string{"token", "password", "passwd", "secret", "apikey", "credential", "pin"},
+		},
+		{
+			name: "replace",
+			cfg:  Config{PatternsMode: PatternsReplace, SensitivePatterns: []string{"pin"}},
+			want: []string{"pin"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.effectivePatterns(); !slices.Equal(got, tt.want) {
+				t.Errorf("effectivePatterns() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigDescribe(t *testing.T) {
+	cfg := Config{
+		SensitivePatterns: []string{"token", "pin"},
+		AllowPatterns:     []string{"page_token"},
+		SensitiveTag:      defaultSensitiveTag,
+	}
+
+	var buf bytes.Buffer
+	cfg.describe(&buf)
+
+	want := "loglinter: sensitive patterns: token, pin\n" +
+		"loglinter: allow patterns: page_token\n" +
+		"loglinter: sensitive tag: log:\"secret\"\n"
+	if buf.String() != want {
+		t.Errorf("describe() = %q, want %q", buf.String(), want)
+	}
+}
@@ -0,0 +1,172 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// stringFuncs — функции, собирающие строку (или ошибку) из своих
+// аргументов. Их аргументы считаются частями сообщения так же, как
+// операнды конкатенации.
+var stringFuncs = map[string]bool{
+	"fmt.Sprintf":  true,
+	"fmt.Sprint":   true,
+	"fmt.Sprintln": true,
+	"fmt.Errorf":   true,
+	"errors.New":   true,
+	"strings.Join": true,
+}
+
+// builderTypes — типы, накапливающие строку через методы Write*
+// и fmt.Fprint*.
+var builderTypes = map[string]bool{
+	"strings.Builder": true,
+	"bytes.Buffer":    true,
+}
+
+// builderWrites — методы builderTypes, дописывающие аргумент в строку.
+var builderWrites = map[string]bool{
+	"Write":       true,
+	"WriteString": true,
+	"WriteByte":   true,
+	"WriteRune":   true,
+}
+
+// fprintFuncs — функции fmt, пишущие в io.Writer, переданный первым
+// аргументом.
+var fprintFuncs = map[string]bool{
+	"fmt.Fprintf":  true,
+	"fmt.Fprint":   true,
+	"fmt.Fprintln": true,
+}
+
+// messageOperands собирает части, из которых строится сообщение:
+// операнды конкатенации (см. collectOperands), аргументы функций из
+// stringFuncs, в том числе обёрнутых вызовом Error()
+// (errors.New(...).Error()), и всё, что было записано в strings.Builder
+// или bytes.Buffer, у которого вызывается String(). Если сообщение само
+// по себе не составное, возвращает nil.
+func messageOperands(pass *analysis.Pass, expr ast.Expr) []ast.Expr {
+	seen := make(map[types.Object]bool)
+	var operands []ast.Expr
+	var expand func(ast.Expr)
+	expand = func(e ast.Expr) {
+		for _, op := range collectOperands(e) {
+			parts, ok := stringParts(pass, op, seen)
+			if !ok {
+				operands = append(operands, op)
+				continue
+			}
+			for _, p := range parts {
+				expand(p)
+			}
+		}
+	}
+	expand(expr)
+
+	if len(operands) == 1 && operands[0] == ast.Unparen(expr) {
+		return nil
+	}
+	return operands
+}
+
+// stringParts раскрывает вызов, строящий строку, в выражения, из
+// которых она составлена. seen защищает от повторного обхода одного
+// и того же strings.Builder.
+func stringParts(pass *analysis.Pass, expr ast.Expr, seen map[types.Object]bool) ([]ast.Expr, bool) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || pass.TypesInfo == nil {
+		return nil, false
+	}
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok {
+		return nil, false
+	}
+
+	sel, isMethod := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if isMethod && fn.Name() == "Error" && len(call.Args) == 0 {
+		// errors.New(...).Error(), fmt.Errorf(...).Error(): метод
+		// интерфейса error, у которого нет пакета.
+		if _, ok := stringParts(pass, ast.Unparen(sel.X), seen); ok {
+			return []ast.Expr{sel.X}, true
+		}
+		return nil, false
+	}
+
+	pkg, recv := funcReceiver(fn)
+	switch {
+	case recv == "" && stringFuncs[pkg+"."+fn.Name()]:
+		if fn.Name() == "Join" && len(call.Args) > 0 {
+			if lit, ok := ast.Unparen(call.Args[0]).(*ast.CompositeLit); ok {
+				return lit.Elts, true
+			}
+			return call.Args[:1], true
+		}
+		return call.Args, true
+	case isMethod && fn.Name() == "String" && builderTypes[pkg+"."+recv]:
+		ident, ok := ast.Unparen(sel.X).(*ast.Ident)
+		if !ok {
+			return nil, false
+		}
+		obj := pass.TypesInfo.Uses[ident]
+		if obj == nil {
+			return nil, false
+		}
+		if seen[obj] {
+			return nil, true
+		}
+		seen[obj] = true
+		return builderArgs(pass, obj), true
+	}
+	return nil, false
+}
+
+// builderArgs собирает аргументы всех записей в strings.Builder или
+// bytes.Buffer obj: b.WriteString(x), b.WriteByte(x), fmt.Fprintf(&b, ...)
+// и т.п. Записи ищутся в файле, где объявлена переменная.
+func builderArgs(pass *analysis.Pass, obj types.Object) []ast.Expr {
+	var args []ast.Expr
+	for _, f := range pass.Files {
+		if f.FileStart > obj.Pos() || obj.Pos() >= f.FileEnd {
+			continue
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+			if !ok {
+				return true
+			}
+			pkg, recv := funcReceiver(fn)
+			switch {
+			case recv != "" && builderTypes[pkg+"."+recv] && builderWrites[fn.Name()]:
+				if sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr); ok && refersTo(pass, sel.X, obj) {
+					args = append(args, call.Args...)
+				}
+			case recv == "" && fprintFuncs[pkg+"."+fn.Name()]:
+				if len(call.Args) > 0 && refersTo(pass, call.Args[0], obj) {
+					args = append(args, call.Args[1:]...)
+				}
+			}
+			return true
+		})
+	}
+	return args
+}
+
+// refersTo сообщает, является ли выражение переменной obj или её
+// адресом (&b).
+func refersTo(pass *analysis.Pass, expr ast.Expr, obj types.Object) bool {
+	expr = ast.Unparen(expr)
+	if u, ok := expr.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		expr = ast.Unparen(u.X)
+	}
+	ident, ok := expr.(*ast.Ident)
+	return ok && pass.TypesInfo.Uses[ident] == obj
+}
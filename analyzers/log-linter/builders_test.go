@@ -0,0 +1,72 @@
+package analyzer
+
+import (
+	"go/types"
+	"slices"
+	"testing"
+)
+
+// ---------- TestMessageOperands ----------
+
+func TestMessageOperands(t *testing.T) {
+	pass, args := typecheck(t, `package p
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func log(string) {}
+
+func f(a, b string, xs []string) {
+	var sb strings.Builder
+	sb.WriteString(a)
+	fmt.Fprintf(&sb, "%s", b)
+	sb.WriteString(sb.String())
+
+	log(a)
+	log(a + "x" + b)
+	log(fmt.Sprintf("%s-%s", a, b))
+	log(strings.Join([]string{a, "x"}, b))
+	log(strings.Join(xs, ","))
+	log(errors.New(a).Error())
+	log(sb.String())
+	log(strings.ToUpper(a))
+}
+`)
+
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{expr: "a"},
+		{expr: `a + "x" + b`, want: []string{"a", "b"}},
+		{expr: `fmt.Sprintf("%s-%s", a, b)`, want: []string{"a", "b"}},
+		{expr: `strings.Join([]string{…}, b)`, want: []string{"a"}},
+		{expr: `strings.Join(xs, ",")`, want: []string{"xs"}},
+		{expr: "errors.New(a).Error()", want: []string{"a"}},
+		{expr: "sb.String()", want: []string{"a", "b"}},
+		{expr: "strings.ToUpper(a)"},
+	}
+
+	operands := map[string][]string{}
+	for _, arg := range args {
+		var names []string
+		for _, op := range messageOperands(pass, arg) {
+			names = append(names, types.ExprString(op))
+		}
+		operands[types.ExprString(arg)] = names
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, ok := operands[tt.expr]
+			if !ok {
+				t.Fatalf("expression %s not found", tt.expr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("messageOperands(%s) = %q, want %q", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
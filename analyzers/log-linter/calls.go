@@ -0,0 +1,121 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"reflect"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// logCall — вызов логгера, найденный анализатором вызовов.
+type logCall struct {
+	// call — вызов с сообщением; для zerolog — завершающий Msg/Msgf.
+	call *ast.CallExpr
+	// fn — расположение сообщения и полей в call.
+	fn logFunc
+	// values — значения, выводимые вместе с сообщением (см. messageArgs).
+	values []ast.Expr
+	// fields — поля вызова: пары ключ-значение, готовые атрибуты
+	// и поля цепочки zerolog, включая вложенные в группы.
+	fields []field
+}
+
+// message возвращает сообщение вызова или nil, если его нет
+// (With и подобные методы).
+func (c logCall) message() ast.Expr {
+	if !c.fn.hasMsg() {
+		return nil
+	}
+	return c.call.Args[c.fn.msg]
+}
+
+// logCalls — результат анализатора вызовов: вызовы логгеров пакета.
+type logCalls struct {
+	calls []logCall
+	// sinks — вызовы логгеров по позиции открывающей скобки; нужны
+	// режиму Taint, чтобы сопоставить им вызовы SSA.
+	sinks map[token.Pos]*ast.CallExpr
+	// ignores — директивы подавления диагностик в файлах пакета.
+	ignores []*ignore
+	// ssa — SSA пакета для режима Taint, общее для всех правил.
+	ssa *ssaProgram
+	// used — директивы, сработавшие для каждого из уже применённых
+	// правил; заполняется только для RuleIgnoreDirective (см. withUsed).
+	used map[string]usedIgnores
+}
+
+// withUsed возвращает копию вызовов с директивами, сработавшими для
+// применённых правил. Сам результат анализатора вызовов общий для всех
+// правил и не меняется.
+func (c *logCalls) withUsed(used map[string]usedIgnores) *logCalls {
+	cp := *c
+	cp.used = used
+	return &cp
+}
+
+// newCallsAnalyzer создаёт анализатор, общий для всех правил: он
+// помечает обёртки над логгерами фактами и собирает вызовы логгеров
+// из реестра reg вместе с их сообщениями и полями.
+func newCallsAnalyzer(reg registry) *analysis.Analyzer {
+	return &analysis.Analyzer{
+		Name: "logcalls",
+		Doc:  "logcalls collects logger calls checked by loglinter rules",
+		Run: func(pass *analysis.Pass) (any, error) {
+			insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+			exportWrapperFacts(pass, insp, reg)
+			return collectCalls(pass, insp, reg), nil
+		},
+		Requires:   []*analysis.Analyzer{inspect.Analyzer},
+		FactTypes:  []analysis.Fact{new(wrapperFact)},
+		ResultType: reflect.TypeFor[*logCalls](),
+	}
+}
+
+// collectCalls собирает вызовы логгеров пакета.
+func collectCalls(pass *analysis.Pass, insp *inspector.Inspector, reg registry) *logCalls {
+	res := &logCalls{
+		sinks:   make(map[token.Pos]*ast.CallExpr),
+		ignores: collectIgnores(pass),
+		ssa:     new(ssaProgram),
+	}
+	nodeFilter := []ast.Node{
+		(*ast.CallExpr)(nil),
+	}
+	insp.Preorder(nodeFilter, func(n ast.Node) {
+		node, ok := n.(*ast.CallExpr)
+		if !ok {
+			return
+		}
+		if ev, ok := parseZerologChain(pass, node); ok {
+			c := logCall{call: ev.call, fn: logFunc{msg: -1}, fields: ev.fields}
+			if ev.msg != nil {
+				c.fn = logFunc{format: ev.format}
+				c.values = ev.call.Args[1:]
+			}
+			res.calls = append(res.calls, c)
+			res.sinks[ev.call.Lparen] = ev.call
+			for _, c := range ev.calls {
+				res.sinks[c.Lparen] = c
+			}
+			return
+		}
+		fn, ok := lookupCall(pass, reg, node)
+		if !ok || len(node.Args) <= fn.msg {
+			return
+		}
+		res.sinks[node.Lparen] = node
+
+		c := logCall{call: node, fn: fn}
+		if fn.hasMsg() {
+			c.values = messageArgs(node, fn)
+		}
+		if fn.hasKV() && len(node.Args) > fn.kv {
+			c.fields = collectKeyValues(pass, node.Args[fn.kv:], nil)
+		}
+		res.calls = append(res.calls, c)
+	})
+	return res
+}
@@ -0,0 +1,133 @@
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// charset — символы, допустимые в лог-сообщениях. Латинские буквы,
+// цифры 0-9 и пробел допустимы всегда; нулевое значение ничего к ним
+// не добавляет.
+type charset struct {
+	// punct — дополнительно разрешённые знаки (Config.AllowedPunctuation).
+	punct string
+	// tables — разрешённые письменности и диапазоны (Config.AllowedScripts).
+	tables []*unicode.RangeTable
+}
+
+// newCharset разбирает допустимые знаки и письменности. Письменность
+// задаётся именем из unicode.Scripts ("Cyrillic") или диапазоном кодовых
+// точек ("U+0400-U+04FF", "U+00E9").
+func newCharset(punct string, scripts []string) (*charset, error) {
+	cs := &charset{punct: punct}
+	var errs []error
+	for _, s := range scripts {
+		table, err := parseScript(s)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		cs.tables = append(cs.tables, table)
+	}
+	return cs, errors.Join(errs...)
+}
+
+// parseScript разбирает имя письменности или диапазон кодовых точек.
+func parseScript(s string) (*unicode.RangeTable, error) {
+	if table, ok := unicode.Scripts[s]; ok {
+		return table, nil
+	}
+	if !strings.HasPrefix(s, "U+") {
+		return nil, fmt.Errorf("unknown script %q", s)
+	}
+	lo, hi, isRange := strings.Cut(s, "-")
+	from, err := parseCodePoint(lo)
+	if err != nil {
+		return nil, fmt.Errorf("script range %q: %w", s, err)
+	}
+	to := from
+	if isRange {
+		if to, err = parseCodePoint(hi); err != nil {
+			return nil, fmt.Errorf("script range %q: %w", s, err)
+		}
+	}
+	if to < from {
+		return nil, fmt.Errorf("script range %q is empty", s)
+	}
+	return &unicode.RangeTable{R32: []unicode.Range32{{Lo: from, Hi: to, Stride: 1}}}, nil
+}
+
+// parseCodePoint разбирает кодовую точку вида U+0400.
+func parseCodePoint(s string) (uint32, error) {
+	hex, ok := strings.CutPrefix(s, "U+")
+	if !ok {
+		return 0, fmt.Errorf("code point %q must start with U+", s)
+	}
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil || n > unicode.MaxRune {
+		return 0, fmt.Errorf("invalid code point %q", s)
+	}
+	return uint32(n), nil
+}
+
+// allowed сообщает, допустим ли символ в сообщении.
+func (cs *charset) allowed(r rune) bool {
+	if (r >= 'a' && r <= 'z') ||
+		(r >= 'A' && r <= 'Z') ||
+		(r >= '0' && r <= '9') ||
+		r == ' ' {
+		return true
+	}
+	if cs == nil {
+		return false
+	}
+	return strings.ContainsRune(cs.punct, r) || unicode.In(r, cs.tables...)
+}
+
+// removeNonLatin удаляет из строки все недопустимые символы: остаются
+// латинские буквы, цифры, пробелы и разрешённые знаки и письменности.
+func (cs *charset) removeNonLatin(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if cs.allowed(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// removeSpecialSymbols удаляет из строки все символы, не являющиеся
+// буквами (любого алфавита), цифрами, пробелами или разрешёнными знаками.
+func (cs *charset) removeSpecialSymbols(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || cs.allowed(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// checkMessagePattern проверяет, что константное сообщение соответствует
+// регулярному выражению re (Config.MessagePattern).
+func checkMessagePattern(pass *analysis.Pass, expr ast.Expr, re *regexp.Regexp) {
+	msg, ok := getMessage(pass, expr)
+	if !ok || re.MatchString(msg.text) {
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:      expr.Pos(),
+		End:      expr.End(),
+		Category: RuleMessagePattern,
+		Message:  fmt.Sprintf("log message does not match pattern %q", re.String()),
+		Related:  msg.related(),
+	})
+}
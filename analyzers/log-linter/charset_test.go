@@ -0,0 +1,73 @@
+package analyzer
+
+import "testing"
+
+// ---------- TestParseScript ----------
+
+func TestParseScript(t *testing.T) {
+	tests := []struct {
+		script  string
+		in      rune
+		out     rune
+		wantErr bool
+	}{
+		{script: "Cyrillic", in: 'ж', out: 'σ'},
+		{script: "Greek", in: 'σ', out: 'ж'},
+		{script: "U+0400-U+04FF", in: 'ж', out: 'σ'},
+		{script: "U+00E9", in: 'é', out: 'è'},
+		{script: "Klingon", wantErr: true},
+		{script: "U+04FF-U+0400", wantErr: true},
+		{script: "U+XYZ", wantErr: true},
+		{script: "U+0400-0500", wantErr: true},
+		{script: "U+110000", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.script, func(t *testing.T) {
+			cs, err := newCharset("", []string{tt.script})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newCharset(%q) error = %v, wantErr %v", tt.script, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !cs.allowed(tt.in) {
+				t.Errorf("allowed(%q) = false, want true", tt.in)
+			}
+			if cs.allowed(tt.out) {
+				t.Errorf("allowed(%q) = true, want false", tt.out)
+			}
+		})
+	}
+}
+
+// ---------- TestCharsetRemove ----------
+
+func TestCharsetRemove(t *testing.T) {
+	cs, err := newCharset(":-", []string{"Cyrillic"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		cs          *charset
+		s           string
+		wantLatin   string
+		wantSpecial string
+	}{
+		{name: "default", s: "host: мир σ!", wantLatin: "host  ", wantSpecial: "host мир σ"},
+		{name: "configured", cs: cs, s: "host: мир σ!", wantLatin: "host: мир ", wantSpecial: "host: мир σ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cs.removeNonLatin(tt.s); got != tt.wantLatin {
+				t.Errorf("removeNonLatin(%q) = %q, want %q", tt.s, got, tt.wantLatin)
+			}
+			if got := tt.cs.removeSpecialSymbols(tt.s); got != tt.wantSpecial {
+				t.Errorf("removeSpecialSymbols(%q) = %q, want %q", tt.s, got, tt.wantSpecial)
+			}
+		})
+	}
+}
@@ -5,145 +5,198 @@ import (
 	"go/ast"
 	"go/token"
 	"go/types"
+	"slices"
 	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
 
 	"golang.org/x/tools/go/analysis"
-	"golang.org/x/tools/go/analysis/passes/inspect"
-	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
 )
 
-func makeRun(cfg Config) func(*analysis.Pass) (any, error) {
-	return func(pass *analysis.Pass) (any, error) {
-		insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
-		nodeFilter := []ast.Node{
-			(*ast.CallExpr)(nil),
+// messageArgs возвращает аргументы, следующие за сообщением вызова
+// и не являющиеся парами ключ-значение: аргументы строки формата,
+// дополнительные аргументы logrus.Info(args...) и т.п.
+func messageArgs(call *ast.CallExpr, fn logFunc) []ast.Expr {
+	end := len(call.Args)
+	if fn.hasKV() && fn.kv < end {
+		end = fn.kv
+	}
+	if end <= fn.msg+1 {
+		return nil
+	}
+	return call.Args[fn.msg+1 : end]
+}
+
+// lookupCall определяет, является ли вызов обращением к логгеру:
+// методу из реестра или обёртке над логгером, помеченной wrapperFact.
+func lookupCall(pass *analysis.Pass, reg registry, call *ast.CallExpr) (logFunc, bool) {
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		if fn, ok := reg.isLinted(pass, sel); ok {
+			return fn, true
 		}
-		insp.Preorder(nodeFilter, func(n ast.Node) {
-			node, ok := n.(*ast.CallExpr)
-			if !ok {
-				return
-			}
-			sel, ok := node.Fun.(*ast.SelectorExpr)
-			if !ok {
-				return
-			}
-			if ok := isLinted(pass, sel); !ok {
-				return
-			}
-			if len(node.Args) == 0 {
-				return
-			}
+	}
 
-			checkStartsWithUpper(pass, node)
-			checkNotAllowedSymbols(pass, node)
-			checkSensitiveData(pass, node.Args[0], cfg.SensitivePatterns)
-		})
-		return nil, nil
+	callee, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok {
+		return logFunc{}, false
+	}
+	var fact wrapperFact
+	if !pass.ImportObjectFact(callee, &fact) {
+		return logFunc{}, false
 	}
+	return logFunc{msg: fact.Msg, kv: fact.KV, format: fact.Format}, true
 }
 
-// Определяет родительский пакет логгера, а также вызванный у него метод.
-// На основе этого принимается решение, линтить ли вызов или нет.
-//
-// Текущие логгеры подлежащие линту:
-//   - log/slog
-//   - go.uber.org/zap
-//
-// Методы:
-//   - Info
-//   - Debug
-//   - Warn
-//   - Error
-//   - Fatal
-func isLinted(pass *analysis.Pass, expr *ast.SelectorExpr) bool {
-	switch expr.Sel.Name {
-	case "Info", "Debug", "Warn", "Error", "Fatal":
-	default:
-		return false
+// getMethodReceiver возвращает путь к пакету и имя типа, в котором объявлен
+// вызываемый метод. Так учитываются методы, продвинутые из встроенных полей:
+// для s.Info(...), где s встраивает *zap.Logger, результатом будет zap.Logger.
+// Для остальных селекторов тип определяется по выражению получателя.
+func getMethodReceiver(pass *analysis.Pass, sel *ast.SelectorExpr) (pkgPath, typeName string) {
+	if s, ok := pass.TypesInfo.Selections[sel]; ok && s.Kind() == types.MethodVal {
+		if fn, ok := s.Obj().(*types.Func); ok {
+			return funcReceiver(fn)
+		}
 	}
+	return getReceiver(pass, sel.X)
+}
 
-	pkgPath := getPackagePath(pass, expr.X)
-
-	return pkgPath == "log/slog" || pkgPath == "go.uber.org/zap"
+// calledFunc возвращает функцию или метод, на который ссылается селектор.
+func calledFunc(pass *analysis.Pass, sel *ast.SelectorExpr) *types.Func {
+	fn, _ := pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+	return fn
 }
 
-// getPackagePath возвращает путь к пакету, в котором определен логгер.
-func getPackagePath(pass *analysis.Pass, expr ast.Expr) string {
-	ident, ok := expr.(*ast.Ident)
-	if !ok {
-		return ""
+// funcReceiver возвращает путь пакета функции и имя типа её получателя.
+// Для функций без получателя имя типа пустое.
+func funcReceiver(fn *types.Func) (pkg, recv string) {
+	if fn == nil || fn.Pkg() == nil {
+		return "", ""
+	}
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok || sig.Recv() == nil {
+		return fn.Pkg().Path(), ""
 	}
 
-	obj := pass.TypesInfo.Uses[ident]
-	if obj == nil {
-		return ""
+	typ := sig.Recv().Type()
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, ok := typ.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return fn.Pkg().Path(), ""
 	}
+	return named.Obj().Pkg().Path(), named.Obj().Name()
+}
 
-	if pkgName, ok := obj.(*types.PkgName); ok {
-		return pkgName.Imported().Path()
+// getReceiver возвращает путь к пакету, в котором определен логгер, и имя
+// его типа. Для обращений к функциям пакета имя типа пустое.
+// Получателем может быть любое выражение: поле структуры (s.logger),
+// результат вызова (zap.L(), logger.WithField(...)), индексное выражение
+// (loggers[0]) и т.д. — тип берётся из информации о типах.
+func getReceiver(pass *analysis.Pass, expr ast.Expr) (pkgPath, typeName string) {
+	var typ types.Type
+	if ident, ok := expr.(*ast.Ident); ok {
+		obj := pass.TypesInfo.Uses[ident]
+		if obj == nil {
+			return "", ""
+		}
+
+		if pkgName, ok := obj.(*types.PkgName); ok {
+			return pkgName.Imported().Path(), ""
+		}
+		typ = obj.Type()
+	} else {
+		typ = pass.TypesInfo.TypeOf(expr)
+	}
+	if typ == nil {
+		return "", ""
 	}
 
-	typ := obj.Type()
 	if ptr, ok := typ.(*types.Pointer); ok {
 		typ = ptr.Elem()
 	}
 	if named, ok := typ.(*types.Named); ok {
 		if pkg := named.Obj().Pkg(); pkg != nil {
-			return pkg.Path()
+			return pkg.Path(), named.Obj().Name()
 		}
 	}
 
-	return ""
+	return "", ""
 }
 
 // checkStartsWithUpper проверяет что лог-сообещние не начинается
 // с заглавной буквы.
-func checkStartsWithUpper(pass *analysis.Pass, expr *ast.CallExpr) {
-	lit, ok := getStringLiteral(expr)
+func checkStartsWithUpper(pass *analysis.Pass, expr ast.Expr) {
+	msg, ok := getMessage(pass, expr)
 	if !ok {
 		return
 	}
 
-	r, _ := utf8.DecodeRuneInString(lit)
-	if unicode.IsUpper(r) {
-		// pass.Reportf(expr.Args[0].Pos(), "log messages must start with lowercase letter")
-		pass.Report(analysis.Diagnostic{
-			Pos:     expr.Args[0].Pos(),
-			End:     expr.Args[0].End(),
-			Message: "log messages must start with lowercase letter",
-			SuggestedFixes: []analysis.SuggestedFix{
-				{
-					Message: fmt.Sprintf("letter %s must be lowercase", string(r)),
-					TextEdits: []analysis.TextEdit{
-						{
-							Pos:     expr.Args[0].Pos() + 1,
-							End:     expr.Args[0].Pos() + 1 + token.Pos(utf8.RuneLen(r)),
-							NewText: []byte(string(unicode.ToLower(r))),
-						},
-					},
-				},
+	r, _ := utf8.DecodeRuneInString(msg.text)
+	if !unicode.IsUpper(r) {
+		return
+	}
+
+	var fixes []analysis.SuggestedFix
+	if edit, ok := lowerFirstEdit(msg, r); ok {
+		fixes = []analysis.SuggestedFix{
+			{
+				Message:   fmt.Sprintf("letter %s must be lowercase", string(r)),
+				TextEdits: []analysis.TextEdit{edit},
 			},
-		})
+		}
+	}
+	pass.Report(analysis.Diagnostic{
+		Pos:            expr.Pos(),
+		End:            expr.End(),
+		Category:       RuleLowercase,
+		Message:        "log messages must start with lowercase letter",
+		SuggestedFixes: fixes,
+		Related:        msg.related(),
+	})
+}
+
+// lowerFirstEdit строит правку первой буквы сообщения в первой непустой
+// его части. Если буква в литерале записана escape-последовательностью,
+// правка невозможна.
+func lowerFirstEdit(msg message, r rune) (analysis.TextEdit, bool) {
+	for _, p := range msg.parts {
+		if p.text == "" {
+			continue
+		}
+		if !strings.HasPrefix(p.lit.Value[1:], string(r)) {
+			return analysis.TextEdit{}, false
+		}
+		return analysis.TextEdit{
+			Pos:     p.lit.Pos() + 1,
+			End:     p.lit.Pos() + 1 + token.Pos(utf8.RuneLen(r)),
+			NewText: []byte(string(unicode.ToLower(r))),
+		}, true
 	}
+	return analysis.TextEdit{}, false
 }
 
 // checkNotAllowedSymbols проверяет что лог-сообщение не содержит
-// нелатинских и специальных символов.
-func checkNotAllowedSymbols(pass *analysis.Pass, expr *ast.CallExpr) {
-	lit, ok := getStringLiteral(expr)
+// нелатинских и специальных символов, кроме разрешённых в cs (nil — только
+// латинские буквы, цифры и пробел). Глаголы строки формата (%s, %d и т.п.)
+// специальными символами не считаются и сохраняются в исправлениях.
+func checkNotAllowedSymbols(pass *analysis.Pass, expr ast.Expr, format bool, cs *charset) {
+	msg, ok := getMessage(pass, expr)
 	if !ok {
 		return
 	}
 
+	var verbs []formatVerb
+	if format {
+		verbs, _ = parseFormat(msg.text)
+	}
+	text := stripVerbs(msg.text, verbs)
+
 	var hasNonLatin, hasSpecial bool
-	for _, r := range lit {
-		if (r >= 'a' && r <= 'z') ||
-			(r >= 'A' && r <= 'Z') ||
-			(r >= '0' && r <= '9') ||
-			r == ' ' {
+	for _, r := range text {
+		if cs.allowed(r) {
 			continue
 		}
 		if unicode.IsLetter(r) {
@@ -154,69 +207,34 @@ func checkNotAllowedSymbols(pass *analysis.Pass, expr *ast.CallExpr) {
 	}
 	if hasNonLatin {
 		pass.Report(analysis.Diagnostic{
-			Pos:     expr.Args[0].Pos(),
-			End:     expr.Args[0].End(),
-			Message: "log messages must only contains latin letters",
-			SuggestedFixes: []analysis.SuggestedFix{
-				{
-					Message: "remove non-latin characters",
-					TextEdits: []analysis.TextEdit{
-						{
-							Pos:     expr.Args[0].Pos(),
-							End:     expr.Args[0].End(),
-							NewText: []byte(strconv.Quote(removeNonLatin(lit))),
-						},
-					},
-				},
-			},
+			Pos:            expr.Pos(),
+			End:            expr.End(),
+			Category:       RuleLatinOnly,
+			Message:        "log messages must only contains latin letters",
+			SuggestedFixes: charsetFix(msg, format, "remove non-latin characters", cs.removeNonLatin),
+			Related:        msg.related(),
 		})
 	}
 	if hasSpecial {
 		pass.Report(analysis.Diagnostic{
-			Pos:     expr.Args[0].Pos(),
-			End:     expr.Args[0].End(),
-			Message: "log messages must not contains any special symbols",
-			SuggestedFixes: []analysis.SuggestedFix{
-				{
-					Message: "remove special symbols",
-					TextEdits: []analysis.TextEdit{
-						{
-							Pos:     expr.Args[0].Pos(),
-							End:     expr.Args[0].End(),
-							NewText: []byte(strconv.Quote(removeSpecialSymbols(lit))),
-						},
-					},
-				},
-			},
+			Pos:            expr.Pos(),
+			End:            expr.End(),
+			Category:       RuleSpecialSymbols,
+			Message:        "log messages must not contains any special symbols",
+			SuggestedFixes: charsetFix(msg, format, "remove special symbols", cs.removeSpecialSymbols),
+			Related:        msg.related(),
 		})
 	}
 }
 
-// removeNonLatin удаляет из строки все символы, не являющиеся
-// латинскими буквами, цифрами или пробелами.
-func removeNonLatin(s string) string {
-	var b strings.Builder
-	for _, r := range s {
-		if (r >= 'a' && r <= 'z') ||
-			(r >= 'A' && r <= 'Z') ||
-			(r >= '0' && r <= '9') ||
-			r == ' ' {
-			b.WriteRune(r)
-		}
-	}
-	return b.String()
-}
-
-// removeSpecialSymbols удаляет из строки все символы, не являющиеся
-// буквами (любого алфавита), цифрами или пробелами.
-func removeSpecialSymbols(s string) string {
-	var b strings.Builder
-	for _, r := range s {
-		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == ' ' {
-			b.WriteRune(r)
-		}
+// charsetFix строит исправление, удаляющее недопустимые символы из всех
+// частей сообщения.
+func charsetFix(msg message, format bool, title string, remove func(string) string) []analysis.SuggestedFix {
+	edits := msg.mapParts(format, remove)
+	if len(edits) == 0 {
+		return nil
 	}
-	return b.String()
+	return []analysis.SuggestedFix{{Message: title, TextEdits: edits}}
 }
 
 // var sensitivePatterns = []string{
@@ -230,53 +248,259 @@ func removeSpecialSymbols(s string) string {
 // 	"private",
 // }
 
-// checkSensitiveData проверяет, не конкатенируется ли в лог-сообщение
-// переменная с потенциально чувствительным именем
-func checkSensitiveData(pass *analysis.Pass, expr ast.Expr, patterns []string) {
-	binExpr, ok := expr.(*ast.BinaryExpr)
-	if !ok || binExpr.Op != token.ADD {
+// checkSensitiveData проверяет, не попадает ли в лог-сообщение
+// потенциально секретное значение: переменная, поле, элемент отображения
+// или заголовок с чувствительным именем (см. isSensitiveExpr), будь то
+// через конкатенацию, fmt.Sprintf, strings.Join или strings.Builder
+// (см. messageOperands).
+func checkSensitiveData(pass *analysis.Pass, expr ast.Expr, names *nameMatcher) {
+	for _, operand := range messageOperands(pass, expr) {
+		if isSensitiveExpr(pass, operand, names) {
+			reportf(pass, RuleSensitiveData, operandPos(expr, operand),
+				"potentially sensitive data %q is concatenated into log message",
+				types.ExprString(operand),
+			)
+		}
+	}
+}
+
+// field — поле, передаваемое логгеру: ключ и значение.
+type field struct {
+	key ast.Expr
+	// value — значение поля; nil, если оно отсутствует (в том числе
+	// у ключа группы).
+	value ast.Expr
+	// groups — ключи групп (slog.Group, zap.Dict), в которые вложено поле.
+	groups []ast.Expr
+}
+
+// newField строит поле из аргументов вида (key, value, ...).
+func newField(args []ast.Expr, groups []ast.Expr) field {
+	f := field{key: args[0], groups: groups}
+	if len(args) > 1 {
+		f.value = args[1]
+	}
+	return f
+}
+
+// checkField проверяет поле: сначала ключ, затем само значение и,
+// наконец, его тип. Так на одно поле приходится не больше одного сообщения
+// о чувствительных данных. Поля группы с чувствительным ключом не
+// проверяются: о ключе группы уже сообщено.
+func checkField(pass *analysis.Pass, f field, cfg Config) {
+	for _, g := range f.groups {
+		if key, ok := getStringValue(pass, g); ok && cfg.names.match(key) {
+			return
+		}
+	}
+	if checkSensitiveKey(pass, f.key, cfg.names) || f.value == nil {
 		return
 	}
+	if !checkSensitiveValue(pass, f.value, cfg.names) {
+		checkSensitiveType(pass, f.value, cfg)
+	}
+}
+
+// checkSensitiveKey проверяет, не передаётся ли в логгер поле
+// с потенциально чувствительным ключом. Сообщает, было ли найдено нарушение.
+func checkSensitiveKey(pass *analysis.Pass, expr ast.Expr, names *nameMatcher) bool {
+	key, ok := getStringValue(pass, expr)
+	if !ok || !names.match(key) {
+		return false
+	}
+
+	reportf(pass, RuleSensitiveData, expr.Pos(), "potentially sensitive key %q is passed to logger", key)
+	return true
+}
+
+// checkSensitiveValue проверяет, не передаётся ли в логгер значение,
+// ссылающееся на потенциально секретные данные (см. isSensitiveExpr).
+// Сообщает, было ли найдено нарушение.
+func checkSensitiveValue(pass *analysis.Pass, expr ast.Expr, names *nameMatcher) bool {
+	expr = ast.Unparen(expr)
+	if !isSensitiveExpr(pass, expr, names) {
+		return false
+	}
 
-	idents := collectIdents(binExpr)
+	reportf(pass, RuleSensitiveData, expr.Pos(), "potentially sensitive data %q is passed to logger", types.ExprString(expr))
+	return true
+}
+
+// collectKeyValues собирает поля из хвоста ключ-значение вызова логгера
+// (slog.Info(msg, "k", v), sugar.Infow(msg, "k", v) и т.п.). Как и сами
+// логгеры, считает строковый аргумент ключом, за которым следует значение,
+// а любой другой аргумент — готовым атрибутом (slog.Attr, zap.Field).
+// groups — ключи групп, в которые вложен хвост.
+func collectKeyValues(pass *analysis.Pass, args []ast.Expr, groups []ast.Expr) []field {
+	var fields []field
+	for i := 0; i < len(args); i++ {
+		if !isString(pass, args[i]) {
+			fields = append(fields, collectAttr(pass, args[i], groups)...)
+			continue
+		}
+		fields = append(fields, newField(args[i:], groups))
+		i++
+	}
+	return fields
+}
+
+// fieldPackages — пакеты, конструкторы полей которых проверяются:
+// функции вида slog.String(key, value) и zap.String(key, value).
+var fieldPackages = map[string]bool{
+	"log/slog":        true,
+	"go.uber.org/zap": true,
+}
+
+// groupConstructors — конструкторы вложенных групп полей вида
+// (key, fields...).
+var groupConstructors = map[string]bool{
+	"log/slog.Group":       true,
+	"go.uber.org/zap.Dict": true,
+}
 
-	for _, ident := range idents {
-		name := strings.ToLower(ident.Name)
-		for _, pattern := range patterns {
-			if strings.Contains(name, pattern) {
-				pass.Reportf(ident.Pos(),
-					"potentially sensitive data %q is concatenated into log message",
-					ident.Name,
-				)
-				break
+// collectAttr собирает поля готового атрибута: вызова конструктора
+// (slog.String(key, value), zap.Any(key, value), zap.Object(key, value)
+// и т.п.), группы (slog.Group(key, args...), zap.Dict(key, fields...)),
+// литерала slog.Attr{Key: key, Value: value} или литерала отображения
+// со строковыми ключами (logrus.Fields{...}). Ключ группы сам становится
+// полем без значения.
+func collectAttr(pass *analysis.Pass, expr ast.Expr, groups []ast.Expr) []field {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.CallExpr:
+		fn, ok := typeutil.Callee(pass.TypesInfo, e).(*types.Func)
+		if !ok || len(e.Args) == 0 || !hasKeyParam(fn) {
+			return nil
+		}
+		pkg, recv := funcReceiver(fn)
+		if !fieldPackages[pkg] || recv != "" {
+			return nil
+		}
+		if groupConstructors[pkg+"."+fn.Name()] {
+			key := field{key: e.Args[0], groups: groups}
+			nested := append(slices.Clip(groups), e.Args[0])
+			return append([]field{key}, collectKeyValues(pass, e.Args[1:], nested)...)
+		}
+		return []field{newField(e.Args, groups)}
+	case *ast.CompositeLit:
+		if isStringMap(pass.TypesInfo.TypeOf(e)) {
+			var fields []field
+			for _, elt := range e.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok {
+					fields = append(fields, field{key: kv.Key, value: kv.Value, groups: groups})
+				}
+			}
+			return fields
+		}
+		if pkg, name := getReceiver(pass, e); pkg != "log/slog" || name != "Attr" {
+			return nil
+		}
+		f := field{groups: groups}
+		for _, elt := range e.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			switch types.ExprString(kv.Key) {
+			case "Key":
+				f.key = kv.Value
+			case "Value":
+				f.value = slogValueArg(pass, kv.Value)
 			}
 		}
+		if f.key != nil {
+			return []field{f}
+		}
+	}
+	return nil
+}
+
+// isStringMap сообщает, является ли тип отображением со строковыми ключами.
+func isStringMap(typ types.Type) bool {
+	if typ == nil {
+		return false
+	}
+	m, ok := typ.Underlying().(*types.Map)
+	return ok && isStringType(m.Key())
+}
+
+// slogValueArg разворачивает значение slog.StringValue(v), slog.AnyValue(v)
+// и т.п. до аргумента конструктора.
+func slogValueArg(pass *analysis.Pass, expr ast.Expr) ast.Expr {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return expr
+	}
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok {
+		return expr
+	}
+	if pkg, recv := funcReceiver(fn); pkg != "log/slog" || recv != "" || !strings.HasSuffix(fn.Name(), "Value") {
+		return expr
+	}
+	return call.Args[0]
+}
+
+// hasKeyParam сообщает, принимает ли fn первым параметром строковый ключ
+// поля (Str(key, val), Int(key, i), Dict(key, dict) и т.д.).
+func hasKeyParam(fn *types.Func) bool {
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok || sig.Params().Len() == 0 {
+		return false
+	}
+	first := sig.Params().At(0)
+	basic, ok := first.Type().(*types.Basic)
+	return first.Name() == "key" && ok && basic.Kind() == types.String
+}
+
+// isString сообщает, имеет ли выражение строковый тип.
+func isString(pass *analysis.Pass, expr ast.Expr) bool {
+	return isStringType(pass.TypesInfo.TypeOf(expr))
+}
+
+// isStringType сообщает, является ли тип строковым.
+func isStringType(typ types.Type) bool {
+	if typ == nil {
+		return false
+	}
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsString != 0
+}
+
+// operandPos возвращает позицию для сообщения о части сообщения expr:
+// позицию самой части, если она находится внутри выражения, иначе —
+// позицию выражения (например, для записи в strings.Builder выше
+// по коду).
+func operandPos(expr, operand ast.Expr) token.Pos {
+	if operand.Pos() < expr.Pos() || operand.Pos() >= expr.End() {
+		return expr.Pos()
 	}
+	return operand.Pos()
 }
 
-// collectIdents рекурсивно собирает все идентификаторы переменных
-// из дерева конкатенации (вложенных BinaryExpr с token.ADD)
-func collectIdents(expr ast.Expr) []*ast.Ident {
-	var idents []*ast.Ident
+// collectOperands рекурсивно собирает операнды дерева конкатенации
+// (вложенных BinaryExpr с token.ADD), кроме литералов.
+func collectOperands(expr ast.Expr) []ast.Expr {
+	var operands []ast.Expr
 	var walk func(ast.Expr)
 	walk = func(e ast.Expr) {
-		switch e := e.(type) {
+		switch e := ast.Unparen(e).(type) {
+		case nil, *ast.BasicLit:
 		case *ast.BinaryExpr:
 			if e.Op == token.ADD {
 				walk(e.X)
 				walk(e.Y)
 			}
-		case *ast.Ident:
-			idents = append(idents, e)
+		default:
+			operands = append(operands, e)
 		}
 	}
 	walk(expr)
-	return idents
+	return operands
 }
 
-// Возвращает строковый литерал первого аргумента функции без кавычек
-func getStringLiteral(node *ast.CallExpr) (string, bool) {
-	lit, ok := node.Args[0].(*ast.BasicLit)
+// Возвращает значение строкового литерала без кавычек
+func getStringLiteral(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
 	if !ok || lit.Kind != token.STRING {
 		return "", false
 	}
@@ -41,6 +41,16 @@ func collectDiagnostics() (*analysis.Pass, *[]analysis.Diagnostic) {
 	return pass, &diags
 }
 
+// makeMethod creates a method named name on *pkgPath.recvName with the given params.
+func makeMethod(pkgPath, recvName, name string, params ...*types.Var) *types.Func {
+	pkg := types.NewPackage(pkgPath, "pkg")
+	typeName := types.NewTypeName(token.NoPos, pkg, recvName, nil)
+	named := types.NewNamed(typeName, types.NewStruct(nil, nil), nil)
+	recv := types.NewVar(token.NoPos, pkg, "r", types.NewPointer(named))
+	sig := types.NewSignatureType(recv, nil, nil, types.NewTuple(params...), nil, false)
+	return types.NewFunc(token.NoPos, pkg, name, sig)
+}
+
 // messages extracts Message strings from a slice of diagnostics.
 func messages(diags []analysis.Diagnostic) []string {
 	out := make([]string, len(diags))
@@ -105,7 +115,7 @@ func TestGetStringLiteral(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, ok := getStringLiteral(tt.node)
+			got, ok := getStringLiteral(tt.node.Args[0])
 			if ok != tt.wantOk {
 				t.Fatalf("getStringLiteral() ok = %v, want %v", ok, tt.wantOk)
 			}
@@ -118,14 +128,14 @@ func TestGetStringLiteral(t *testing.T) {
 
 // ---------- TestCollectIdents ----------
 
-func TestCollectIdents(t *testing.T) {
+func TestCollectOperands(t *testing.T) {
 	t.Run("single ident", func(t *testing.T) {
 		id := &ast.Ident{Name: "x"}
 
-		idents := collectIdents(id)
+		idents := collectOperands(id)
 
-		if len(idents) != 1 || idents[0].Name != "x" {
-			t.Fatalf("expected [x], got %v", identNames(idents))
+		if names := operandNames(idents); len(names) != 1 || names[0] != "x" {
+			t.Fatalf("expected [x], got %v", operandNames(idents))
 		}
 	})
 
@@ -136,9 +146,9 @@ func TestCollectIdents(t *testing.T) {
 			Y:  &ast.Ident{Name: "b"},
 		}
 
-		idents := collectIdents(expr)
+		idents := collectOperands(expr)
 
-		names := identNames(idents)
+		names := operandNames(idents)
 		if len(names) != 2 || names[0] != "a" || names[1] != "b" {
 			t.Fatalf("expected [a b], got %v", names)
 		}
@@ -156,9 +166,9 @@ func TestCollectIdents(t *testing.T) {
 			Y: &ast.Ident{Name: "c"},
 		}
 
-		idents := collectIdents(expr)
+		idents := collectOperands(expr)
 
-		names := identNames(idents)
+		names := operandNames(idents)
 		if len(names) != 3 || names[0] != "a" || names[1] != "b" || names[2] != "c" {
 			t.Fatalf("expected [a b c], got %v", names)
 		}
@@ -171,10 +181,10 @@ func TestCollectIdents(t *testing.T) {
 			Y:  &ast.Ident{Name: "b"},
 		}
 
-		idents := collectIdents(expr)
+		idents := collectOperands(expr)
 
 		if len(idents) != 0 {
-			t.Fatalf("expected [], got %v", identNames(idents))
+			t.Fatalf("expected [], got %v", operandNames(idents))
 		}
 	})
 
@@ -185,26 +195,42 @@ func TestCollectIdents(t *testing.T) {
 			Y:  &ast.Ident{Name: "x"},
 		}
 
-		idents := collectIdents(expr)
+		idents := collectOperands(expr)
+
+		if names := operandNames(idents); len(names) != 1 || names[0] != "x" {
+			t.Fatalf("expected [x], got %v", operandNames(idents))
+		}
+	})
+
+	t.Run("selector and index are collected", func(t *testing.T) {
+		expr := &ast.BinaryExpr{
+			Op: token.ADD,
+			X:  &ast.SelectorExpr{X: &ast.Ident{Name: "user"}, Sel: &ast.Ident{Name: "Password"}},
+			Y: &ast.ParenExpr{X: &ast.IndexExpr{
+				X:     &ast.Ident{Name: "m"},
+				Index: &ast.BasicLit{Kind: token.STRING, Value: `"k"`},
+			}},
+		}
 
-		if len(idents) != 1 || idents[0].Name != "x" {
-			t.Fatalf("expected [x], got %v", identNames(idents))
+		names := operandNames(collectOperands(expr))
+		if len(names) != 2 || names[0] != "user.Password" || names[1] != `m["k"]` {
+			t.Fatalf(`expected [user.Password m["k"]], got %v`, names)
 		}
 	})
 
 	t.Run("nil expression", func(t *testing.T) {
 
-		idents := collectIdents(nil)
+		idents := collectOperands(nil)
 		if len(idents) != 0 {
-			t.Fatalf("expected [], got %v", identNames(idents))
+			t.Fatalf("expected [], got %v", operandNames(idents))
 		}
 	})
 }
 
-func identNames(idents []*ast.Ident) []string {
-	names := make([]string, len(idents))
-	for i, id := range idents {
-		names[i] = id.Name
+func operandNames(operands []ast.Expr) []string {
+	names := make([]string, len(operands))
+	for i, op := range operands {
+		names[i] = types.ExprString(op)
 	}
 	return names
 }
@@ -252,7 +278,7 @@ func TestCheckStartsWithUpper(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			pass, diags := collectDiagnostics()
-			checkStartsWithUpper(pass, tt.node)
+			checkStartsWithUpper(pass, tt.node.Args[0])
 			if len(*diags) != tt.wantDiags {
 				t.Errorf("got %d diagnostics, want %d: %v", len(*diags), tt.wantDiags, messages(*diags))
 			}
@@ -272,6 +298,7 @@ func TestCheckNotAllowedSymbols(t *testing.T) {
 	tests := []struct {
 		name         string
 		node         *ast.CallExpr
+		format       bool
 		wantNonLatin bool
 		wantSpecial  bool
 	}{
@@ -317,6 +344,26 @@ func TestCheckNotAllowedSymbols(t *testing.T) {
 			wantNonLatin: true,
 			wantSpecial:  false,
 		},
+		{
+			name:         "format verbs are not special",
+			node:         makeLitCall(token.STRING, `"user %s not found %5.2f %-3d %[1]v"`),
+			format:       true,
+			wantNonLatin: false,
+			wantSpecial:  false,
+		},
+		{
+			name:         "percent is special without format",
+			node:         makeLitCall(token.STRING, `"user %s not found"`),
+			wantNonLatin: false,
+			wantSpecial:  true,
+		},
+		{
+			name:         "escaped percent is special in format",
+			node:         makeLitCall(token.STRING, `"done 100%%"`),
+			format:       true,
+			wantNonLatin: false,
+			wantSpecial:  true,
+		},
 		{
 			name:         "emoji is special",
 			node:         makeLitCall(token.STRING, "\"hello\\u2764\""),
@@ -328,7 +375,7 @@ func TestCheckNotAllowedSymbols(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			pass, diags := collectDiagnostics()
-			checkNotAllowedSymbols(pass, tt.node)
+			checkNotAllowedSymbols(pass, tt.node.Args[0], tt.format, nil)
 
 			msgs := messages(*diags)
 			hasNonLatin := containsMsg(msgs, "log messages must only contains latin letters")
@@ -463,7 +510,7 @@ func TestCheckSensitiveData(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			pass, diags := collectDiagnostics()
-			checkSensitiveData(pass, tt.expr, defaultSensitivePatterns)
+			checkSensitiveData(pass, tt.expr, mustNameMatcher(defaultSensitivePatterns...))
 			if len(*diags) != tt.wantDiags {
 				t.Fatalf("got %d diagnostics, want %d: %v", len(*diags), tt.wantDiags, messages(*diags))
 			}
@@ -523,57 +570,163 @@ func TestIsLinted(t *testing.T) {
 
 	t.Run("slog Info is linted", func(t *testing.T) {
 		pass, sel := makeSlogPass("Info")
-		if !isLinted(pass, sel) {
+		if _, ok := newRegistry(defaultLoggers).isLinted(pass, sel); !ok {
 			t.Error("expected slog.Info to be linted")
 		}
 	})
 
 	t.Run("slog Debug is linted", func(t *testing.T) {
 		pass, sel := makeSlogPass("Debug")
-		if !isLinted(pass, sel) {
+		if _, ok := newRegistry(defaultLoggers).isLinted(pass, sel); !ok {
 			t.Error("expected slog.Debug to be linted")
 		}
 	})
 
 	t.Run("slog Warn is linted", func(t *testing.T) {
 		pass, sel := makeSlogPass("Warn")
-		if !isLinted(pass, sel) {
+		if _, ok := newRegistry(defaultLoggers).isLinted(pass, sel); !ok {
 			t.Error("expected slog.Warn to be linted")
 		}
 	})
 
 	t.Run("slog Error is linted", func(t *testing.T) {
 		pass, sel := makeSlogPass("Error")
-		if !isLinted(pass, sel) {
+		if _, ok := newRegistry(defaultLoggers).isLinted(pass, sel); !ok {
 			t.Error("expected slog.Error to be linted")
 		}
 	})
 
 	t.Run("slog Fatal is linted", func(t *testing.T) {
 		pass, sel := makeSlogPass("Fatal")
-		if !isLinted(pass, sel) {
+		if _, ok := newRegistry(defaultLoggers).isLinted(pass, sel); !ok {
 			t.Error("expected slog.Fatal to be linted")
 		}
 	})
 
 	t.Run("zap Info is linted", func(t *testing.T) {
 		pass, sel := makeZapPass("Info")
-		if !isLinted(pass, sel) {
+		if _, ok := newRegistry(defaultLoggers).isLinted(pass, sel); !ok {
 			t.Error("expected zap.Info to be linted")
 		}
 	})
 
 	t.Run("zap Error is linted", func(t *testing.T) {
 		pass, sel := makeZapPass("Error")
-		if !isLinted(pass, sel) {
+		if _, ok := newRegistry(defaultLoggers).isLinted(pass, sel); !ok {
 			t.Error("expected zap.Error to be linted")
 		}
 	})
 
-	t.Run("slog non linted method", func(t *testing.T) {
+	t.Run("logrus Infof is linted", func(t *testing.T) {
+		pkg := types.NewPackage("github.com/sirupsen/logrus", "logrus")
+		pkgName := types.NewPkgName(token.NoPos, nil, "logrus", pkg)
+
+		ident := &ast.Ident{Name: "logrus"}
+		sel := &ast.SelectorExpr{
+			X:   ident,
+			Sel: &ast.Ident{Name: "Infof"},
+		}
+		pass := &analysis.Pass{
+			TypesInfo: &types.Info{
+				Uses: map[*ast.Ident]types.Object{
+					ident: pkgName,
+				},
+			},
+		}
+		if _, ok := newRegistry(defaultLoggers).isLinted(pass, sel); !ok {
+			t.Error("expected logrus.Infof to be linted")
+		}
+	})
+
+	t.Run("logrus WithField attaches fields", func(t *testing.T) {
+		pkg := types.NewPackage("github.com/sirupsen/logrus", "logrus")
+		pkgName := types.NewPkgName(token.NoPos, nil, "logrus", pkg)
+
+		ident := &ast.Ident{Name: "logrus"}
+		sel := &ast.SelectorExpr{
+			X:   ident,
+			Sel: &ast.Ident{Name: "WithField"},
+		}
+		pass := &analysis.Pass{
+			TypesInfo: &types.Info{
+				Uses: map[*ast.Ident]types.Object{
+					ident: pkgName,
+				},
+			},
+		}
+		fn, ok := newRegistry(defaultLoggers).isLinted(pass, sel)
+		if !ok {
+			t.Fatal("expected logrus.WithField to be linted")
+		}
+		if fn.hasMsg() || !fn.hasKV() || fn.kv != 0 {
+			t.Errorf("logrus.WithField: got %+v, want no message and key-values from 0", fn)
+		}
+	})
+
+	t.Run("slog InfoContext message is second argument", func(t *testing.T) {
+		pass, sel := makeSlogPass("InfoContext")
+		fn, ok := newRegistry(defaultLoggers).isLinted(pass, sel)
+		if !ok {
+			t.Fatal("expected slog.InfoContext to be linted")
+		}
+		if fn.msg != 1 || fn.kv != 2 {
+			t.Errorf("logFunc = %+v, want {msg:1 kv:2}", fn)
+		}
+	})
+
+	t.Run("slog LogAttrs message is third argument", func(t *testing.T) {
+		pass, sel := makeSlogPass("LogAttrs")
+		fn, ok := newRegistry(defaultLoggers).isLinted(pass, sel)
+		if !ok {
+			t.Fatal("expected slog.LogAttrs to be linted")
+		}
+		if fn.msg != 2 || fn.kv != 3 {
+			t.Errorf("logFunc = %+v, want {msg:2 kv:3}", fn)
+		}
+	})
+
+	t.Run("zap SugaredLogger Infow has key-values", func(t *testing.T) {
+		pkg := types.NewPackage("go.uber.org/zap", "zap")
+		typeName := types.NewTypeName(token.NoPos, pkg, "SugaredLogger", nil)
+		named := types.NewNamed(typeName, types.NewStruct(nil, nil), nil)
+		varObj := types.NewVar(token.NoPos, pkg, "sugar", types.NewPointer(named))
+
+		ident := &ast.Ident{Name: "sugar"}
+		sel := &ast.SelectorExpr{
+			X:   ident,
+			Sel: &ast.Ident{Name: "Infow"},
+		}
+		pass := &analysis.Pass{
+			TypesInfo: &types.Info{
+				Uses: map[*ast.Ident]types.Object{
+					ident: varObj,
+				},
+			},
+		}
+		fn, ok := newRegistry(defaultLoggers).isLinted(pass, sel)
+		if !ok {
+			t.Fatal("expected sugar.Infow to be linted")
+		}
+		if fn.msg != 0 || fn.kv != 1 {
+			t.Errorf("logFunc = %+v, want {msg:0 kv:1}", fn)
+		}
+	})
+
+	t.Run("slog With attaches key-values", func(t *testing.T) {
 		pass, sel := makeSlogPass("With")
-		if isLinted(pass, sel) {
-			t.Error("expected slog.With not to be linted")
+		fn, ok := newRegistry(defaultLoggers).isLinted(pass, sel)
+		if !ok {
+			t.Fatal("expected slog.With to be linted")
+		}
+		if fn.hasMsg() || !fn.hasKV() || fn.kv != 0 {
+			t.Errorf("slog.With: got %+v, want no message and key-values from 0", fn)
+		}
+	})
+
+	t.Run("slog non linted method", func(t *testing.T) {
+		pass, sel := makeSlogPass("SetDefault")
+		if _, ok := newRegistry(defaultLoggers).isLinted(pass, sel); ok {
+			t.Error("expected slog.SetDefault not to be linted")
 		}
 	})
 
@@ -593,7 +746,7 @@ func TestIsLinted(t *testing.T) {
 				},
 			},
 		}
-		if isLinted(pass, sel) {
+		if _, ok := newRegistry(defaultLoggers).isLinted(pass, sel); ok {
 			t.Error("expected fmt.Info not to be linted")
 		}
 	})
@@ -609,15 +762,15 @@ func TestIsLinted(t *testing.T) {
 				Uses: map[*ast.Ident]types.Object{},
 			},
 		}
-		if isLinted(pass, sel) {
+		if _, ok := newRegistry(defaultLoggers).isLinted(pass, sel); ok {
 			t.Error("expected unresolved.Info not to be linted")
 		}
 	})
 }
 
-// ---------- TestGetPackagePath ----------
+// ---------- TestGetReceiver ----------
 
-func TestGetPackagePath(t *testing.T) {
+func TestGetReceiver(t *testing.T) {
 	t.Run("package name returns import path", func(t *testing.T) {
 		pkg := types.NewPackage("log/slog", "slog")
 		pkgName := types.NewPkgName(token.NoPos, nil, "slog", pkg)
@@ -631,9 +784,9 @@ func TestGetPackagePath(t *testing.T) {
 			},
 		}
 
-		got := getPackagePath(pass, ident)
+		got, _ := getReceiver(pass, ident)
 		if got != "log/slog" {
-			t.Errorf("getPackagePath() = %q, want %q", got, "log/slog")
+			t.Errorf("getReceiver() = %q, want %q", got, "log/slog")
 		}
 	})
 
@@ -643,9 +796,9 @@ func TestGetPackagePath(t *testing.T) {
 				Uses: map[*ast.Ident]types.Object{},
 			},
 		}
-		got := getPackagePath(pass, &ast.BasicLit{Kind: token.STRING, Value: `"x"`})
+		got, _ := getReceiver(pass, &ast.BasicLit{Kind: token.STRING, Value: `"x"`})
 		if got != "" {
-			t.Errorf("getPackagePath() = %q, want empty", got)
+			t.Errorf("getReceiver() = %q, want empty", got)
 		}
 	})
 
@@ -656,9 +809,9 @@ func TestGetPackagePath(t *testing.T) {
 				Uses: map[*ast.Ident]types.Object{},
 			},
 		}
-		got := getPackagePath(pass, ident)
+		got, _ := getReceiver(pass, ident)
 		if got != "" {
-			t.Errorf("getPackagePath() = %q, want empty", got)
+			t.Errorf("getReceiver() = %q, want empty", got)
 		}
 	})
 
@@ -677,9 +830,12 @@ func TestGetPackagePath(t *testing.T) {
 			},
 		}
 
-		got := getPackagePath(pass, ident)
+		got, name := getReceiver(pass, ident)
 		if got != "go.uber.org/zap" {
-			t.Errorf("getPackagePath() = %q, want %q", got, "go.uber.org/zap")
+			t.Errorf("getReceiver() = %q, want %q", got, "go.uber.org/zap")
+		}
+		if name != "Logger" {
+			t.Errorf("getReceiver() type = %q, want %q", name, "Logger")
 		}
 	})
 
@@ -699,9 +855,36 @@ func TestGetPackagePath(t *testing.T) {
 			},
 		}
 
-		got := getPackagePath(pass, ident)
+		got, name := getReceiver(pass, ident)
 		if got != "go.uber.org/zap" {
-			t.Errorf("getPackagePath() = %q, want %q", got, "go.uber.org/zap")
+			t.Errorf("getReceiver() = %q, want %q", got, "go.uber.org/zap")
+		}
+		if name != "Logger" {
+			t.Errorf("getReceiver() type = %q, want %q", name, "Logger")
+		}
+	})
+
+	t.Run("selector expression uses its type", func(t *testing.T) {
+		pkg := types.NewPackage("log/slog", "slog")
+		typeName := types.NewTypeName(token.NoPos, pkg, "Logger", nil)
+		named := types.NewNamed(typeName, types.NewStruct(nil, nil), nil)
+
+		// s.logger
+		expr := &ast.SelectorExpr{
+			X:   &ast.Ident{Name: "s"},
+			Sel: &ast.Ident{Name: "logger"},
+		}
+		pass := &analysis.Pass{
+			TypesInfo: &types.Info{
+				Types: map[ast.Expr]types.TypeAndValue{
+					expr: {Type: types.NewPointer(named)},
+				},
+			},
+		}
+
+		got, name := getReceiver(pass, expr)
+		if got != "log/slog" || name != "Logger" {
+			t.Errorf("getReceiver() = (%q, %q), want (%q, %q)", got, name, "log/slog", "Logger")
 		}
 	})
 
@@ -717,9 +900,84 @@ func TestGetPackagePath(t *testing.T) {
 			},
 		}
 
-		got := getPackagePath(pass, ident)
+		got, _ := getReceiver(pass, ident)
 		if got != "" {
-			t.Errorf("getPackagePath() = %q, want empty", got)
+			t.Errorf("getReceiver() = %q, want empty", got)
+		}
+	})
+}
+
+// ---------- TestFuncReceiver ----------
+
+func TestFuncReceiver(t *testing.T) {
+	t.Run("nil func", func(t *testing.T) {
+		pkg, recv := funcReceiver(nil)
+		if pkg != "" || recv != "" {
+			t.Errorf("funcReceiver(nil) = (%q, %q), want empty", pkg, recv)
+		}
+	})
+
+	t.Run("package func", func(t *testing.T) {
+		p := types.NewPackage(zerologLogPath, "log")
+		sig := types.NewSignatureType(nil, nil, nil, nil, nil, false)
+		fn := types.NewFunc(token.NoPos, p, "Info", sig)
+
+		pkg, recv := funcReceiver(fn)
+		if pkg != zerologLogPath || recv != "" {
+			t.Errorf("funcReceiver() = (%q, %q), want (%q, \"\")", pkg, recv, zerologLogPath)
 		}
 	})
+
+	t.Run("pointer receiver method", func(t *testing.T) {
+		fn := makeMethod(zerologPath, "Event", "Msg")
+
+		pkg, recv := funcReceiver(fn)
+		if pkg != zerologPath || recv != "Event" {
+			t.Errorf("funcReceiver() = (%q, %q), want (%q, \"Event\")", pkg, recv, zerologPath)
+		}
+	})
+}
+
+// ---------- TestHasKeyParam ----------
+
+func TestHasKeyParam(t *testing.T) {
+	str := types.Typ[types.String]
+	tests := []struct {
+		name string
+		fn   *types.Func
+		want bool
+	}{
+		{
+			name: "Str(key, val string)",
+			fn: makeMethod(zerologPath, "Event", "Str",
+				types.NewVar(token.NoPos, nil, "key", str),
+				types.NewVar(token.NoPos, nil, "val", str)),
+			want: true,
+		},
+		{
+			name: "Err(err error)",
+			fn: makeMethod(zerologPath, "Event", "Err",
+				types.NewVar(token.NoPos, nil, "err", types.Universe.Lookup("error").Type())),
+			want: false,
+		},
+		{
+			name: "Int key of non string type",
+			fn: makeMethod(zerologPath, "Event", "Weird",
+				types.NewVar(token.NoPos, nil, "key", types.Typ[types.Int])),
+			want: false,
+		},
+		{
+			name: "no params",
+			fn:   makeMethod(zerologPath, "Event", "Stack"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasKeyParam(tt.fn); got != tt.want {
+				t.Errorf("hasKeyParam() = %v, want %v", got, tt.want)
+			}
+		})
+	}
 }
@@ -0,0 +1,176 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// formatVerb — глагол printf-подобной строки формата.
+type formatVerb struct {
+	// start, end — байтовые границы глагола в строке, включая %,
+	// флаги, ширину и точность.
+	start, end int
+	// verb — сам глагол: s, d, v и т.д.
+	verb rune
+	// argNum — номер аргумента (с единицы), который читает глагол.
+	argNum int
+	// indexed — глагол, его ширина или точность задают явный индекс [n].
+	indexed bool
+}
+
+// parseFormat разбирает строку формата по правилам пакета fmt.
+// Возвращает глаголы и наибольший номер аргумента, который читает строка
+// (с учётом ширины и точности *, а также явных индексов [n]).
+// Экранированный процент %% глаголом не считается и аргументов не читает.
+func parseFormat(s string) (verbs []formatVerb, maxArg int) {
+	argNum := 0
+	use := func() int {
+		argNum++
+		maxArg = max(maxArg, argNum)
+		return argNum
+	}
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' {
+			continue
+		}
+		start := i
+		i++
+		if i < len(s) && s[i] == '%' {
+			continue
+		}
+		for i < len(s) && strings.IndexByte("+-# 0", s[i]) >= 0 {
+			i++
+		}
+		indexed := false
+		argIndex := func(i int) int {
+			j := parseArgIndex(s, i, &argNum)
+			indexed = indexed || j != i
+			return j
+		}
+		i = argIndex(i)
+		i = parseNum(s, i, use)
+		if i < len(s) && s[i] == '.' {
+			i++
+			i = argIndex(i)
+			i = parseNum(s, i, use)
+		}
+		i = argIndex(i)
+		if i >= len(s) {
+			// Глагол отсутствует: остаток строки — обычный текст.
+			break
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		verbs = append(verbs, formatVerb{
+			start:   start,
+			end:     i + size,
+			verb:    r,
+			argNum:  use(),
+			indexed: indexed,
+		})
+		i += size - 1
+	}
+	return verbs, maxArg
+}
+
+// parseArgIndex разбирает явный индекс аргумента [n] и устанавливает
+// по нему номер следующего читаемого аргумента.
+func parseArgIndex(s string, i int, argNum *int) int {
+	if i >= len(s) || s[i] != '[' {
+		return i
+	}
+	j := strings.IndexByte(s[i:], ']')
+	if j < 0 {
+		return i
+	}
+	var n int
+	if _, err := fmt.Sscanf(s[i+1:i+j], "%d", &n); err == nil && n > 0 {
+		*argNum = n - 1
+	}
+	return i + j + 1
+}
+
+// parseNum разбирает ширину или точность: число либо *, читающую аргумент.
+func parseNum(s string, i int, use func() int) int {
+	if i < len(s) && s[i] == '*' {
+		use()
+		return i + 1
+	}
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	return i
+}
+
+// mapOutsideVerbs применяет f к участкам строки между глаголами формата,
+// оставляя сами глаголы нетронутыми. Без глаголов f применяется ко всей строке.
+func mapOutsideVerbs(s string, verbs []formatVerb, f func(string) string) string {
+	var b strings.Builder
+	prev := 0
+	for _, v := range verbs {
+		b.WriteString(f(s[prev:v.start]))
+		b.WriteString(s[v.start:v.end])
+		prev = v.end
+	}
+	b.WriteString(f(s[prev:]))
+	return b.String()
+}
+
+// stripVerbs возвращает строку без глаголов формата.
+func stripVerbs(s string, verbs []formatVerb) string {
+	var b strings.Builder
+	prev := 0
+	for _, v := range verbs {
+		b.WriteString(s[prev:v.start])
+		prev = v.end
+	}
+	b.WriteString(s[prev:])
+	return b.String()
+}
+
+// checkFormatArgs сверяет число аргументов вызова с числом аргументов,
+// которые читает строка формата, как это делает go vet для пакета fmt.
+// Аргументами формата считаются все аргументы вызова после сообщения.
+// Как и go vet, при явных индексах [n] лишние аргументы не считаются
+// ошибкой: строка может намеренно читать не все из них.
+func checkFormatArgs(pass *analysis.Pass, call *ast.CallExpr, msgIdx int) {
+	if call.Ellipsis.IsValid() || len(call.Args) <= msgIdx {
+		return
+	}
+	msg, ok := getMessage(pass, call.Args[msgIdx])
+	if !ok {
+		return
+	}
+	lit := msg.text
+
+	name := types.ExprString(call.Fun)
+	nargs := len(call.Args) - msgIdx - 1
+	verbs, maxArg := parseFormat(lit)
+	indexed := false
+	for _, v := range verbs {
+		if v.argNum > nargs {
+			reportf(pass, RuleFormatArgs, call.Pos(), "%s format %s reads arg #%d, but call has %s",
+				name, lit[v.start:v.end], v.argNum, count(nargs, "arg"))
+			return
+		}
+		indexed = indexed || v.indexed
+	}
+	if maxArg < nargs && !indexed {
+		reportf(pass, RuleFormatArgs, call.Pos(), "%s call needs %s but has %s",
+			name, count(maxArg, "arg"), count(nargs, "arg"))
+	}
+}
+
+// count возвращает строку вида "1 arg" или "2 args".
+func count(n int, what string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, what)
+	}
+	return fmt.Sprintf("%d %ss", n, what)
+}
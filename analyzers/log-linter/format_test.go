@@ -0,0 +1,142 @@
+package analyzer
+
+import (
+	"strings"
+	"testing"
+)
+
+// ---------- TestParseFormat ----------
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        string
+		wantVerbs []string
+		wantArgs  []int
+		wantMax   int
+	}{
+		{
+			name:    "no verbs",
+			in:      "hello world",
+			wantMax: 0,
+		},
+		{
+			name:      "simple verbs",
+			in:        "user %s has %d items",
+			wantVerbs: []string{"%s", "%d"},
+			wantArgs:  []int{1, 2},
+			wantMax:   2,
+		},
+		{
+			name:      "flags width precision",
+			in:        "%-5s %+.2f %#x %08d",
+			wantVerbs: []string{"%-5s", "%+.2f", "%#x", "%08d"},
+			wantArgs:  []int{1, 2, 3, 4},
+			wantMax:   4,
+		},
+		{
+			name:      "escaped percent is not a verb",
+			in:        "100%% done %v",
+			wantVerbs: []string{"%v"},
+			wantArgs:  []int{1},
+			wantMax:   1,
+		},
+		{
+			name:      "star width reads an argument",
+			in:        "%*d",
+			wantVerbs: []string{"%*d"},
+			wantArgs:  []int{2},
+			wantMax:   2,
+		},
+		{
+			name:      "explicit argument indexes",
+			in:        "%[2]s %[1]d %s",
+			wantVerbs: []string{"%[2]s", "%[1]d", "%s"},
+			wantArgs:  []int{2, 1, 2},
+			wantMax:   2,
+		},
+		{
+			name:      "explicit index for star width",
+			in:        "%[2]*[1]d %d",
+			wantVerbs: []string{"%[2]*[1]d", "%d"},
+			wantArgs:  []int{1, 2},
+			wantMax:   2,
+		},
+		{
+			name:    "trailing percent without verb",
+			in:      "progress %",
+			wantMax: 0,
+		},
+		{
+			name:      "unicode verb",
+			in:        "%л",
+			wantVerbs: []string{"%л"},
+			wantArgs:  []int{1},
+			wantMax:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verbs, maxArg := parseFormat(tt.in)
+			if len(verbs) != len(tt.wantVerbs) {
+				t.Fatalf("got %d verbs, want %d: %+v", len(verbs), len(tt.wantVerbs), verbs)
+			}
+			for i, v := range verbs {
+				if got := tt.in[v.start:v.end]; got != tt.wantVerbs[i] {
+					t.Errorf("verb %d = %q, want %q", i, got, tt.wantVerbs[i])
+				}
+				if v.argNum != tt.wantArgs[i] {
+					t.Errorf("verb %d reads arg #%d, want #%d", i, v.argNum, tt.wantArgs[i])
+				}
+				if want := strings.Contains(tt.wantVerbs[i], "["); v.indexed != want {
+					t.Errorf("verb %d indexed = %v, want %v", i, v.indexed, want)
+				}
+			}
+			if maxArg != tt.wantMax {
+				t.Errorf("maxArg = %d, want %d", maxArg, tt.wantMax)
+			}
+		})
+	}
+}
+
+// ---------- TestMapOutsideVerbs ----------
+
+func TestMapOutsideVerbs(t *testing.T) {
+	tests := []struct {
+		in      string
+		format  bool
+		want    string
+		wantRaw string
+	}{
+		{in: "user %s not found!", format: true, want: "USER %s NOT FOUND!", wantRaw: "user  not found!"},
+		{in: "%d%%", format: true, want: "%d%%", wantRaw: "%%"},
+		{in: "user %s", format: false, want: "USER %S", wantRaw: "user %s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var verbs []formatVerb
+			if tt.format {
+				verbs, _ = parseFormat(tt.in)
+			}
+			if got := mapOutsideVerbs(tt.in, verbs, strings.ToUpper); got != tt.want {
+				t.Errorf("mapOutsideVerbs() = %q, want %q", got, tt.want)
+			}
+			if got := stripVerbs(tt.in, verbs); got != tt.wantRaw {
+				t.Errorf("stripVerbs() = %q, want %q", got, tt.wantRaw)
+			}
+		})
+	}
+}
+
+// ---------- TestCount ----------
+
+func TestCount(t *testing.T) {
+	if got := count(1, "arg"); got != "1 arg" {
+		t.Errorf("count(1) = %q", got)
+	}
+	if got := count(0, "arg"); got != "0 args" {
+		t.Errorf("count(0) = %q", got)
+	}
+}
@@ -0,0 +1,178 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Директивы подавления диагностик:
+//
+//	//loglinter:ignore <rule-id>[,<rule-id>...] -- причина
+//	//loglinter:file-ignore <rule-id>[,<rule-id>...] -- причина
+//
+// Первая подавляет диагностики перечисленных правил на своей строке,
+// а если стоит на отдельной строке — и на следующей; вторая — во всём файле. Текст после " //" в директиве
+// не учитывается, так что за ней можно оставить обычный комментарий.
+const (
+	ignoreDirective     = "loglinter:ignore"
+	fileIgnoreDirective = "loglinter:file-ignore"
+)
+
+// ignore — разобранная директива подавления.
+type ignore struct {
+	pos  token.Pos
+	file string
+	line int
+	// fileLevel — директива loglinter:file-ignore.
+	fileLevel bool
+	// trailing — директива стоит после кода на той же строке и поэтому
+	// не распространяется на следующую.
+	trailing bool
+	rules    []string
+	// unknown — идентификаторы из rules, которым не соответствует ни одно
+	// правило. Заполняется в collectIgnores: проверка правил из rules
+	// напрямую создала бы цикл инициализации.
+	unknown []string
+	reason  string
+}
+
+// name возвращает имя директивы для сообщений.
+func (ig *ignore) name() string {
+	if ig.fileLevel {
+		return fileIgnoreDirective
+	}
+	return ignoreDirective
+}
+
+// suppresses сообщает, подавляет ли директива диагностику правила id
+// в позиции pos.
+func (ig *ignore) suppresses(id string, pos token.Position) bool {
+	if !slices.Contains(ig.rules, id) || pos.Filename != ig.file {
+		return false
+	}
+	return ig.fileLevel || pos.Line == ig.line || !ig.trailing && pos.Line == ig.line+1
+}
+
+// collectIgnores собирает директивы подавления из комментариев пакета.
+func collectIgnores(pass *analysis.Pass) []*ignore {
+	var ignores []*ignore
+	for _, f := range pass.Files {
+		var code map[int]token.Pos
+		for _, group := range f.Comments {
+			for _, c := range group.List {
+				ig, ok := parseIgnore(pass.Fset, c)
+				if !ok {
+					continue
+				}
+				if code == nil {
+					code = codeStarts(pass.Fset, f)
+				}
+				if start, ok := code[ig.line]; ok && start < c.Pos() {
+					ig.trailing = true
+				}
+				for _, id := range ig.rules {
+					if !isRule(id) {
+						ig.unknown = append(ig.unknown, id)
+					}
+				}
+				ignores = append(ignores, ig)
+			}
+		}
+	}
+	return ignores
+}
+
+// codeStarts возвращает для каждой строки файла позицию первого начала
+// или конца узла синтаксического дерева на ней. Комментарии не учитываются,
+// так что строки, на которых есть только комментарии, в результат не входят.
+func codeStarts(fset *token.FileSet, f *ast.File) map[int]token.Pos {
+	starts := make(map[int]token.Pos)
+	add := func(pos token.Pos) {
+		line := fset.Position(pos).Line
+		if start, ok := starts[line]; !ok || pos < start {
+			starts[line] = pos
+		}
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n.(type) {
+		case nil, *ast.CommentGroup, *ast.Comment:
+			return false
+		}
+		add(n.Pos())
+		add(n.End() - 1)
+		return true
+	})
+	return starts
+}
+
+// parseIgnore разбирает комментарий-директиву подавления.
+func parseIgnore(fset *token.FileSet, c *ast.Comment) (*ignore, bool) {
+	text, ok := strings.CutPrefix(c.Text, "//")
+	if !ok {
+		return nil, false
+	}
+	ig := &ignore{pos: c.Pos()}
+	switch {
+	case strings.HasPrefix(text, fileIgnoreDirective):
+		ig.fileLevel = true
+		text = text[len(fileIgnoreDirective):]
+	case strings.HasPrefix(text, ignoreDirective):
+		text = text[len(ignoreDirective):]
+	default:
+		return nil, false
+	}
+	if text != "" && text[0] != ' ' && text[0] != '\t' {
+		// loglinter:ignored и т.п. — не директива.
+		return nil, false
+	}
+	text, _, _ = strings.Cut(text, " //")
+
+	ids, reason, _ := strings.Cut(text, "--")
+	ig.rules = strings.FieldsFunc(ids, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+	ig.reason = strings.TrimSpace(reason)
+
+	pos := fset.Position(c.Pos())
+	ig.file, ig.line = pos.Filename, pos.Line
+	return ig, true
+}
+
+// checkIgnores проверяет сами директивы: у каждой должно быть хотя бы одно
+// известное правило и причина.
+func checkIgnores(pass *analysis.Pass, ignores []*ignore) {
+	for _, ig := range ignores {
+		if len(ig.rules) == 0 {
+			reportf(pass, RuleIgnoreDirective, ig.pos, "%s directive must name at least one rule", ig.name())
+		}
+		for _, id := range ig.unknown {
+			reportf(pass, RuleIgnoreDirective, ig.pos, "unknown rule %q in %s directive", id, ig.name())
+		}
+		if ig.reason == "" {
+			reportf(pass, RuleIgnoreDirective, ig.pos, "%s directive must have a reason after --", ig.name())
+		}
+	}
+}
+
+// usedIgnores — директивы, подавившие хотя бы одну диагностику правила.
+// Это результат анализатора каждого правила (см. NewAnalyzers).
+type usedIgnores map[*ignore]bool
+
+// reportUnusedIgnores сообщает о директивах, которые не подавили ни одной
+// диагностики перечисленного в них правила. used — сработавшие директивы
+// по идентификаторам применённых правил; правила, которые не применялись
+// или выключены в cfg, не учитываются.
+func reportUnusedIgnores(pass *analysis.Pass, ignores []*ignore, used map[string]usedIgnores, cfg Config) {
+	for _, ig := range ignores {
+		for _, id := range ig.rules {
+			ruleUsed, ok := used[id]
+			if ok && cfg.ruleEnabled(id) && !ruleUsed[ig] {
+				reportf(pass, RuleIgnoreDirective, ig.pos, "unused %s directive for rule %q", ig.name(), id)
+			}
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"slices"
+	"testing"
+)
+
+// ---------- TestParseIgnore ----------
+
+func TestParseIgnore(t *testing.T) {
+	tests := []struct {
+		text      string
+		ok        bool
+		fileLevel bool
+		rules     []string
+		reason    string
+	}{
+		{text: "//loglinter:ignore lowercase -- service name", ok: true, rules: []string{"lowercase"}, reason: "service name"},
+		{text: "//loglinter:ignore lowercase, pii --why", ok: true, rules: []string{"lowercase", "pii"}, reason: "why"},
+		{text: "//loglinter:ignore lowercase", ok: true, rules: []string{"lowercase"}},
+		{text: "//loglinter:ignore -- reason", ok: true, reason: "reason"},
+		{text: "//loglinter:ignore pii -- reason // comment", ok: true, rules: []string{"pii"}, reason: "reason"},
+		{text: "//loglinter:file-ignore latin-only -- localized", ok: true, fileLevel: true, rules: []string{"latin-only"}, reason: "localized"},
+		{text: "// loglinter:ignore lowercase -- reason"},
+		{text: "//loglinter:ignored lowercase -- reason"},
+		{text: "/* loglinter:ignore lowercase -- reason */"},
+		{text: "//nolint:loglinter"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			fset := token.NewFileSet()
+			f := fset.AddFile("p.go", -1, 100)
+			f.SetLines([]int{0, 50})
+			c := &ast.Comment{Slash: f.Pos(60), Text: tt.text}
+
+			ig, ok := parseIgnore(fset, c)
+			if ok != tt.ok {
+				t.Fatalf("parseIgnore(%q) ok = %v, want %v", tt.text, ok, tt.ok)
+			}
+			if !ok {
+				return
+			}
+			if ig.fileLevel != tt.fileLevel {
+				t.Errorf("fileLevel = %v, want %v", ig.fileLevel, tt.fileLevel)
+			}
+			if !slices.Equal(ig.rules, tt.rules) {
+				t.Errorf("rules = %q, want %q", ig.rules, tt.rules)
+			}
+			if ig.reason != tt.reason {
+				t.Errorf("reason = %q, want %q", ig.reason, tt.reason)
+			}
+			if ig.file != "p.go" || ig.line != 2 {
+				t.Errorf("position = %s:%d, want p.go:2", ig.file, ig.line)
+			}
+		})
+	}
+}
+
+// ---------- TestIgnoreSuppresses ----------
+
+func TestIgnoreSuppresses(t *testing.T) {
+	line := &ignore{file: "p.go", line: 10, rules: []string{RuleLowercase}}
+	trailing := &ignore{file: "p.go", line: 10, trailing: true, rules: []string{RuleLowercase}}
+	file := &ignore{file: "p.go", line: 1, fileLevel: true, rules: []string{RuleLowercase}}
+
+	tests := []struct {
+		name string
+		ig   *ignore
+		id   string
+		pos  token.Position
+		want bool
+	}{
+		{name: "same line", ig: line, id: RuleLowercase, pos: token.Position{Filename: "p.go", Line: 10}, want: true},
+		{name: "next line", ig: line, id: RuleLowercase, pos: token.Position{Filename: "p.go", Line: 11}, want: true},
+		{name: "previous line", ig: line, id: RuleLowercase, pos: token.Position{Filename: "p.go", Line: 9}},
+		{name: "trailing same line", ig: trailing, id: RuleLowercase, pos: token.Position{Filename: "p.go", Line: 10}, want: true},
+		{name: "trailing next line", ig: trailing, id: RuleLowercase, pos: token.Position{Filename: "p.go", Line: 11}},
+		{name: "two lines below", ig: line, id: RuleLowercase, pos: token.Position{Filename: "p.go", Line: 12}},
+		{name: "other rule", ig: line, id: RulePII, pos: token.Position{Filename: "p.go", Line: 10}},
+		{name: "other file", ig: line, id: RuleLowercase, pos: token.Position{Filename: "q.go", Line: 10}},
+		{name: "file level", ig: file, id: RuleLowercase, pos: token.Position{Filename: "p.go", Line: 500}, want: true},
+		{name: "file level other file", ig: file, id: RuleLowercase, pos: token.Position{Filename: "q.go", Line: 500}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ig.suppresses(tt.id, tt.pos); got != tt.want {
+				t.Errorf("suppresses(%q, %v) = %v, want %v", tt.id, tt.pos, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// message — вычисленное значение лог-сообщения.
+//
+// Сообщение может быть задано не только литералом, но и константным
+// выражением: именованной константой, конкатенацией литералов и констант.
+// В этом случае значение вычисляется компилятором, а исправления вносятся
+// в литералы, из которых оно складывается, — в том числе в объявления
+// констант.
+type message struct {
+	// expr — аргумент вызова.
+	expr ast.Expr
+	// text — значение сообщения.
+	text string
+	// parts — строковые литералы, из которых складывается сообщение,
+	// в порядке следования. Пусто, если хотя бы одну часть нельзя
+	// исправить (константа из другого пакета, преобразование типа и т.п.).
+	parts []messagePart
+}
+
+// messagePart — строковый литерал, входящий в сообщение.
+type messagePart struct {
+	lit  *ast.BasicLit
+	text string
+}
+
+// getMessage вычисляет значение лог-сообщения. Пустые и неконстантные
+// сообщения не проверяются.
+func getMessage(pass *analysis.Pass, expr ast.Expr) (message, bool) {
+	if text, ok := getStringLiteral(expr); ok {
+		lit := expr.(*ast.BasicLit)
+		return message{
+			expr:  expr,
+			text:  text,
+			parts: []messagePart{{lit: lit, text: text}},
+		}, true
+	}
+	text, ok := getStringValue(pass, expr)
+	if !ok {
+		return message{}, false
+	}
+
+	parts, ok := messageParts(pass, expr)
+	if !ok {
+		parts = nil
+	}
+	return message{expr: expr, text: text, parts: parts}, true
+}
+
+// getStringValue возвращает значение строкового литерала или константы.
+func getStringValue(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	if text, ok := getStringLiteral(expr); ok {
+		return text, true
+	}
+	if pass.TypesInfo == nil {
+		return "", false
+	}
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	text := constant.StringVal(tv.Value)
+	return text, text != ""
+}
+
+// messageParts раскладывает константное строковое выражение на литералы.
+func messageParts(pass *analysis.Pass, expr ast.Expr) ([]messagePart, bool) {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return nil, false
+		}
+		text, err := strconv.Unquote(e.Value)
+		if err != nil {
+			return nil, false
+		}
+		return []messagePart{{lit: e, text: text}}, true
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			return nil, false
+		}
+		x, ok := messageParts(pass, e.X)
+		if !ok {
+			return nil, false
+		}
+		y, ok := messageParts(pass, e.Y)
+		if !ok {
+			return nil, false
+		}
+		return append(x, y...), true
+	case *ast.Ident:
+		return constParts(pass, pass.TypesInfo.Uses[e])
+	case *ast.SelectorExpr:
+		return constParts(pass, pass.TypesInfo.Uses[e.Sel])
+	}
+	return nil, false
+}
+
+// constParts раскладывает на литералы значение константы, объявленной
+// в анализируемом пакете.
+func constParts(pass *analysis.Pass, obj types.Object) ([]messagePart, bool) {
+	c, ok := obj.(*types.Const)
+	if !ok || c.Pkg() != pass.Pkg {
+		return nil, false
+	}
+	value := constValueExpr(pass, c)
+	if value == nil {
+		return nil, false
+	}
+	return messageParts(pass, value)
+}
+
+// constValueExpr возвращает выражение из объявления константы или nil,
+// если значение в объявлении опущено (повторение выражения в блоке const).
+func constValueExpr(pass *analysis.Pass, c *types.Const) ast.Expr {
+	for _, f := range pass.Files {
+		if c.Pos() < f.FileStart || c.Pos() >= f.FileEnd {
+			continue
+		}
+		path, _ := astutil.PathEnclosingInterval(f, c.Pos(), c.Pos())
+		for _, n := range path {
+			spec, ok := n.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+			for i, name := range spec.Names {
+				if name.Pos() == c.Pos() && i < len(spec.Values) {
+					return spec.Values[i]
+				}
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// related возвращает ссылки на части сообщения, объявленные вне вызова:
+// исправления будут внесены туда.
+func (m message) related() []analysis.RelatedInformation {
+	var related []analysis.RelatedInformation
+	for _, p := range m.parts {
+		if p.lit.Pos() >= m.expr.Pos() && p.lit.End() <= m.expr.End() {
+			continue
+		}
+		related = append(related, analysis.RelatedInformation{
+			Pos:     p.lit.Pos(),
+			End:     p.lit.End(),
+			Message: "message constant is declared here",
+		})
+	}
+	return related
+}
+
+// mapParts строит правки, применяющие f к каждой части сообщения.
+// Части, которые f не меняет, не правятся.
+func (m message) mapParts(format bool, f func(string) string) []analysis.TextEdit {
+	var edits []analysis.TextEdit
+	for _, p := range m.parts {
+		var verbs []formatVerb
+		if format {
+			verbs, _ = parseFormat(p.text)
+		}
+		text := mapOutsideVerbs(p.text, verbs, f)
+		if text == p.text {
+			continue
+		}
+		edits = append(edits, analysis.TextEdit{
+			Pos:     p.lit.Pos(),
+			End:     p.lit.End(),
+			NewText: []byte(strconv.Quote(text)),
+		})
+	}
+	return edits
+}
@@ -0,0 +1,109 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// typecheck parses and type-checks src and returns a pass over it together
+// with the first argument of every call in the function "f".
+func typecheck(t *testing.T, src string) (*analysis.Pass, []ast.Expr) {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "p.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := &types.Info{
+		Types: map[ast.Expr]types.TypeAndValue{},
+		Defs:  map[*ast.Ident]types.Object{},
+		Uses:  map[*ast.Ident]types.Object{},
+	}
+	pkg, err := (&types.Config{Importer: importer.Default()}).Check("p", fset, []*ast.File{f}, info)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var args []ast.Expr
+	ast.Inspect(f, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && len(call.Args) > 0 {
+			args = append(args, call.Args[0])
+		}
+		return true
+	})
+	return &analysis.Pass{Fset: fset, Files: []*ast.File{f}, Pkg: pkg, TypesInfo: info}, args
+}
+
+// ---------- TestGetMessage ----------
+
+func TestGetMessage(t *testing.T) {
+	pass, args := typecheck(t, `package p
+
+import "os"
+
+const (
+	start  = "Starting"
+	prefix = "server "
+	full   = prefix + "ready"
+)
+
+func log(string) {}
+
+func f(s string) {
+	log("hello")
+	log(start)
+	log("a" + "b")
+	log(full)
+	log(os.DevNull)
+	log(s)
+	log("")
+}
+`)
+
+	tests := []struct {
+		name        string
+		wantOk      bool
+		wantText    string
+		wantParts   int
+		wantRelated int
+	}{
+		{name: "literal", wantOk: true, wantText: "hello", wantParts: 1},
+		{name: "named constant", wantOk: true, wantText: "Starting", wantParts: 1, wantRelated: 1},
+		{name: "literal concatenation", wantOk: true, wantText: "ab", wantParts: 2},
+		{name: "nested constants", wantOk: true, wantText: "server ready", wantParts: 2, wantRelated: 2},
+		{name: "foreign constant", wantOk: true, wantText: "/dev/null", wantParts: 0},
+		{name: "variable", wantOk: false},
+		{name: "empty literal", wantOk: false},
+	}
+	if len(args) != len(tests) {
+		t.Fatalf("got %d call arguments, want %d", len(args), len(tests))
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, ok := getMessage(pass, args[i])
+			if ok != tt.wantOk {
+				t.Fatalf("getMessage() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !ok {
+				return
+			}
+			if msg.text != tt.wantText {
+				t.Errorf("text = %q, want %q", msg.text, tt.wantText)
+			}
+			if len(msg.parts) != tt.wantParts {
+				t.Errorf("got %d parts, want %d", len(msg.parts), tt.wantParts)
+			}
+			if got := len(msg.related()); got != tt.wantRelated {
+				t.Errorf("got %d related, want %d", got, tt.wantRelated)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// namePattern — разобранный паттерн имени из SensitivePatterns или
+// AllowPatterns. Поддерживаются три вида паттернов:
+//   - /regexp/ — регулярное выражение, без учёта регистра;
+//   - glob с метасимволами *, ? и [ ] (синтаксис path.Match) —
+//     сопоставляется со всем именем, без учёта регистра;
+//   - слово — совпадает с одним или несколькими подряд идущими словами
+//     имени, разбитого по camelCase, snake_case и т.п.: "auth" совпадает
+//     с authToken и auth_header, но не с author и oauthProvider, а "apikey" —
+//     с apiKey и API_KEY. Допускается окончание множественного числа:
+//     "credential" совпадает с credentials. Слитные имена без границ
+//     слов (accesstoken) со словом не совпадают — для них нужен glob
+//     ("*token") или регулярное выражение.
+type namePattern struct {
+	re   *regexp.Regexp
+	glob string
+	word string
+}
+
+// parseNamePattern разбирает паттерн имени.
+func parseNamePattern(s string) (namePattern, error) {
+	switch {
+	case len(s) >= 2 && strings.HasPrefix(s, "/") && strings.HasSuffix(s, "/"):
+		re, err := regexp.Compile("(?i)" + s[1:len(s)-1])
+		if err != nil {
+			return namePattern{}, fmt.Errorf("pattern %q: %w", s, err)
+		}
+		return namePattern{re: re}, nil
+	case strings.ContainsAny(s, "*?["):
+		glob := strings.ToLower(s)
+		if _, err := path.Match(glob, ""); err != nil {
+			return namePattern{}, fmt.Errorf("pattern %q: %w", s, err)
+		}
+		return namePattern{glob: glob}, nil
+	}
+
+	word := strings.Join(splitName(s), "")
+	if word == "" {
+		return namePattern{}, fmt.Errorf("pattern %q: no letters or digits", s)
+	}
+	return namePattern{word: word}, nil
+}
+
+// match сообщает, совпадает ли имя name, разбитое на слова words,
+// с паттерном.
+func (p namePattern) match(name string, words []string) bool {
+	switch {
+	case p.re != nil:
+		return p.re.MatchString(name)
+	case p.glob != "":
+		ok, _ := path.Match(p.glob, strings.ToLower(name))
+		return ok
+	}
+
+	for i := range words {
+		joined := ""
+		for _, w := range words[i:] {
+			joined += w
+			if joined == p.word || joined == p.word+"s" {
+				return true
+			}
+			if len(joined) > len(p.word) {
+				break
+			}
+		}
+	}
+	return false
+}
+
+// nameMatcher определяет, является ли имя (переменной, поля, ключа)
+// потенциально чувствительным.
+type nameMatcher struct {
+	sensitive []namePattern
+	allowed   []namePattern
+	// noHeaders отключает sensitiveHeaders в isSensitiveHeader: заголовки
+	// Authorization и Cookie несут секреты, но не персональные данные.
+	noHeaders bool
+}
+
+// newNameMatcher разбирает паттерны чувствительных и разрешённых имён.
+func newNameMatcher(sensitive, allowed []string) (*nameMatcher, error) {
+	m := &nameMatcher{}
+	var errs []error
+	for _, s := range sensitive {
+		p, err := parseNamePattern(s)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("sensitive %w", err))
+			continue
+		}
+		m.sensitive = append(m.sensitive, p)
+	}
+	for _, s := range allowed {
+		p, err := parseNamePattern(s)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("allow %w", err))
+			continue
+		}
+		m.allowed = append(m.allowed, p)
+	}
+	return m, errors.Join(errs...)
+}
+
+// mustNameMatcher — newNameMatcher для встроенных паттернов.
+func mustNameMatcher(sensitive ...string) *nameMatcher {
+	m, err := newNameMatcher(sensitive, nil)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// match сообщает, совпадает ли имя с одним из чувствительных паттернов
+// и ни с одним из разрешённых.
+func (m *nameMatcher) match(name string) bool {
+	if m == nil {
+		return false
+	}
+	words := splitName(name)
+	for _, p := range m.allowed {
+		if p.match(name, words) {
+			return false
+		}
+	}
+	for _, p := range m.sensitive {
+		if p.match(name, words) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitName разбивает имя на слова в нижнем регистре: по небуквенным
+// символам, по переходам между строчными и заглавными буквами, а также
+// между буквами и цифрами. "userAPIKey_v2" даёт [user api key v 2].
+func splitName(name string) []string {
+	var words []string
+	runes := []rune(name)
+	start := -1
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				words = append(words, strings.ToLower(string(runes[start:i])))
+				start = -1
+			}
+			continue
+		}
+		if start >= 0 && isWordBoundary(runes, i) {
+			words = append(words, strings.ToLower(string(runes[start:i])))
+			start = i
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, strings.ToLower(string(runes[start:])))
+	}
+	return words
+}
+
+// isWordBoundary сообщает, начинается ли с runes[i] новое слово внутри
+// последовательности букв и цифр.
+func isWordBoundary(runes []rune, i int) bool {
+	prev, cur := runes[i-1], runes[i]
+	switch {
+	case unicode.IsDigit(prev) != unicode.IsDigit(cur):
+		return true
+	case unicode.IsLower(prev) && unicode.IsUpper(cur):
+		return true
+	case unicode.IsUpper(prev) && unicode.IsUpper(cur):
+		// Конец аббревиатуры: "APIKey" — граница перед K.
+		return i+1 < len(runes) && unicode.IsLower(runes[i+1])
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package analyzer
+
+import (
+	"slices"
+	"testing"
+)
+
+// ---------- TestSplitName ----------
+
+func TestSplitName(t *testing.T) {
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{name: "authToken", want: []string{"auth", "token"}},
+		{name: "auth_token", want: []string{"auth", "token"}},
+		{name: "X-Api-Key", want: []string{"x", "api", "key"}},
+		{name: "APIKey", want: []string{"api", "key"}},
+		{name: "userAPIKey_v2", want: []string{"user", "api", "key", "v", "2"}},
+		{name: "AccessTOKEN", want: []string{"access", "token"}},
+		{name: "oauth2Provider", want: []string{"oauth", "2", "provider"}},
+		{name: "author", want: []string{"author"}},
+		{name: "secret/db", want: []string{"secret", "db"}},
+		{name: "", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitName(tt.name); !slices.Equal(got, tt.want) {
+				t.Errorf("splitName(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+// ---------- TestNameMatcher ----------
+
+func TestNameMatcher(t *testing.T) {
+	tests := []struct {
+		name      string
+		sensitive []string
+		allowed   []string
+		input     string
+		want      bool
+	}{
+		{name: "word matches camelCase", sensitive: []string{"auth"}, input: "authToken", want: true},
+		{name: "word matches snake_case", sensitive: []string{"auth"}, input: "x_auth_header", want: true},
+		{name: "word does not match longer word", sensitive: []string{"auth"}, input: "author"},
+		{name: "word does not match inside word", sensitive: []string{"auth"}, input: "oauthProvider"},
+		{name: "word does not match end of compound", sensitive: []string{"auth"}, input: "oauth"},
+		{name: "word does not match lowercase compound", sensitive: []string{"token"}, input: "accesstoken"},
+		{name: "word does not match start of compound", sensitive: []string{"password"}, input: "passwordhint"},
+		{name: "word does not match uppercase compound", sensitive: []string{"token"}, input: "APITOKEN"},
+		{name: "glob matches lowercase compound", sensitive: []string{"*token"}, input: "accesstoken", want: true},
+		{name: "glob matches uppercase compound", sensitive: []string{"*password"}, input: "DBPASSWORD", want: true},
+		{name: "glob does not match other compound", sensitive: []string{"*token"}, input: "oauth"},
+		{name: "word spans several words", sensitive: []string{"apikey"}, input: "STRIPE_API_KEY", want: true},
+		{name: "word with separators", sensitive: []string{"api_key"}, input: "apiKey", want: true},
+		{name: "plural", sensitive: []string{"credential"}, input: "userCredentials", want: true},
+		{name: "case insensitive", sensitive: []string{"token"}, input: "AccessTOKEN", want: true},
+		{name: "glob", sensitive: []string{"*_key"}, input: "signing_key", want: true},
+		{name: "glob no match", sensitive: []string{"*_key"}, input: "keyring"},
+		{name: "regexp", sensitive: []string{"/^pw[0-9]*$/"}, input: "PW2", want: true},
+		{name: "regexp no match", sensitive: []string{"/^pw[0-9]*$/"}, input: "pwd_hint"},
+		{name: "allow wins", sensitive: []string{"token"}, allowed: []string{"nextPageToken"}, input: "nextPageToken"},
+		{name: "allow is exact word sequence", sensitive: []string{"token"}, allowed: []string{"page_token"}, input: "authToken", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := newNameMatcher(tt.sensitive, tt.allowed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := m.match(tt.input); got != tt.want {
+				t.Errorf("match(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// ---------- TestNewNameMatcherErrors ----------
+
+func TestNewNameMatcherErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		sensitive []string
+		allowed   []string
+	}{
+		{name: "bad regexp", sensitive: []string{"/(/"}},
+		{name: "bad glob", sensitive: []string{"[token"}},
+		{name: "no letters", sensitive: []string{"__"}},
+		{name: "bad allow pattern", allowed: []string{"/[/"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newNameMatcher(tt.sensitive, tt.allowed); err == nil {
+				t.Error("newNameMatcher() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"maps"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Override — настройки, действующие только в части кода: в пакетах, путь
+// которых совпадает с одним из Packages, и в файлах, имя которых совпадает
+// с одним из Files. Если заданы оба списка, должны совпасть оба.
+// Подходящие переопределения применяются поверх Config в порядке
+// объявления.
+type Override struct {
+	// Packages — шаблоны путей пакетов в синтаксисе path.Match
+	// ("example.com/app/cmd/*"); шаблон, оканчивающийся на "/...",
+	// совпадает с пакетом и всеми его подпакетами.
+	Packages []string
+	// Files — шаблоны имён файлов в синтаксисе path.Match. Шаблон без "/"
+	// сравнивается с базовым именем файла ("*_gen.go"), шаблон с "/" —
+	// с таким же числом последних элементов пути ("cmd/*/main.go").
+	Files []string
+	// Rules — настройки правил; заданные Enabled и Severity заменяют
+	// значения из Config.Rules.
+	Rules map[string]RuleConfig
+	// SensitivePatterns и AllowPatterns дополняют одноимённые списки
+	// Config.
+	SensitivePatterns []string
+	AllowPatterns     []string
+	// AllowedPunctuation и AllowedScripts дополняют одноимённые
+	// настройки Config.
+	AllowedPunctuation string
+	AllowedScripts     []string
+}
+
+func (o Override) validate() error {
+	var errs []error
+	if len(o.Packages) == 0 && len(o.Files) == 0 {
+		errs = append(errs, errors.New("at least one package or file pattern is required"))
+	}
+	for _, p := range o.Packages {
+		if _, err := path.Match(strings.TrimSuffix(p, "/..."), ""); err != nil {
+			errs = append(errs, fmt.Errorf("package pattern %q: %w", p, err))
+		}
+	}
+	for _, p := range o.Files {
+		if _, err := path.Match(p, ""); err != nil {
+			errs = append(errs, fmt.Errorf("file pattern %q: %w", p, err))
+		}
+	}
+	if _, err := newNameMatcher(o.SensitivePatterns, o.AllowPatterns); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := newCharset(o.AllowedPunctuation, o.AllowedScripts); err != nil {
+		errs = append(errs, err)
+	}
+	if err := (Config{Rules: o.Rules}).validateRules(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// matches сообщает, действует ли переопределение в файле file пакета pkg.
+func (o Override) matches(pkg, file string) bool {
+	return (len(o.Packages) == 0 || matchPackage(o.Packages, pkg)) &&
+		(len(o.Files) == 0 || matchFile(o.Files, file))
+}
+
+// matchPackage сообщает, совпадает ли путь пакета с одним из шаблонов
+// (см. Override.Packages).
+func matchPackage(patterns []string, pkg string) bool {
+	elems := strings.Split(pkg, "/")
+	for _, p := range patterns {
+		prefix, tree := strings.CutSuffix(p, "/...")
+		if !tree {
+			if ok, _ := path.Match(p, pkg); ok {
+				return true
+			}
+			continue
+		}
+		// Шаблон prefix сравнивается с таким же числом первых элементов
+		// пути: так совпадают и сам пакет, и его подпакеты.
+		n := strings.Count(prefix, "/") + 1
+		if n > len(elems) {
+			continue
+		}
+		if ok, _ := path.Match(prefix, strings.Join(elems[:n], "/")); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// matchFile сообщает, совпадает ли имя файла с одним из шаблонов
+// (см. Override.Files).
+func matchFile(patterns []string, file string) bool {
+	elems := strings.Split(filepath.ToSlash(file), "/")
+	for _, p := range patterns {
+		n := strings.Count(p, "/") + 1
+		if n > len(elems) {
+			continue
+		}
+		if ok, _ := path.Match(p, strings.Join(elems[len(elems)-n:], "/")); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// withOverride возвращает конфигурацию, дополненную переопределением o.
+// Результат нужно заново скомпилировать (см. compile).
+func (c Config) withOverride(o Override) Config {
+	c.SensitivePatterns = append(slices.Clip(c.SensitivePatterns), o.SensitivePatterns...)
+	c.AllowPatterns = append(slices.Clip(c.AllowPatterns), o.AllowPatterns...)
+	c.AllowedPunctuation += o.AllowedPunctuation
+	c.AllowedScripts = append(slices.Clip(c.AllowedScripts), o.AllowedScripts...)
+	if len(o.Rules) > 0 {
+		rules := maps.Clone(c.Rules)
+		if rules == nil {
+			rules = make(map[string]RuleConfig, len(o.Rules))
+		}
+		for id, rc := range o.Rules {
+			merged := rules[id]
+			if rc.Enabled != nil {
+				merged.Enabled = rc.Enabled
+			}
+			if rc.Severity != "" {
+				merged.Severity = rc.Severity
+			}
+			rules[id] = merged
+		}
+		c.Rules = rules
+	}
+	return c
+}
+
+// scope — вызовы логгеров из файлов пакета, в которых действует одна
+// и та же конфигурация.
+type scope struct {
+	cfg   Config
+	calls *logCalls
+}
+
+// scopes делит вызовы логгеров пакета по конфигурациям, действующим
+// в их файлах (см. Config.Overrides).
+func (l *linter) scopes(pass *analysis.Pass, calls *logCalls) []*scope {
+	if len(l.cfg.Overrides) == 0 {
+		return []*scope{{cfg: l.cfg, calls: calls}}
+	}
+
+	var scopes []*scope
+	byKey := make(map[string]*scope)
+	byFile := make(map[*token.File]*scope)
+	for _, f := range pass.Files {
+		tf := pass.Fset.File(f.FileStart)
+		var matched []string
+		for i, o := range l.cfg.Overrides {
+			if o.matches(pass.Pkg.Path(), tf.Name()) {
+				matched = append(matched, strconv.Itoa(i))
+			}
+		}
+		key := strings.Join(matched, ",")
+		s, ok := byKey[key]
+		if !ok {
+			s = &scope{
+				cfg:   l.overrideConfig(key),
+				calls: &logCalls{sinks: make(map[token.Pos]*ast.CallExpr), ssa: calls.ssa, used: calls.used},
+			}
+			byKey[key] = s
+			scopes = append(scopes, s)
+		}
+		byFile[tf] = s
+	}
+
+	for _, c := range calls.calls {
+		s := byFile[pass.Fset.File(c.call.Pos())]
+		s.calls.calls = append(s.calls.calls, c)
+	}
+	for pos, call := range calls.sinks {
+		byFile[pass.Fset.File(pos)].calls.sinks[pos] = call
+	}
+	for _, ig := range calls.ignores {
+		s := byFile[pass.Fset.File(ig.pos)]
+		s.calls.ignores = append(s.calls.ignores, ig)
+	}
+	return scopes
+}
+
+// overrideConfig возвращает скомпилированную конфигурацию с применёнными
+// переопределениями, индексы которых перечислены в key через запятую.
+// Конфигурации кешируются: анализаторы разных пакетов работают
+// параллельно, но наборы переопределений у них повторяются.
+func (l *linter) overrideConfig(key string) Config {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if cfg, ok := l.configs[key]; ok {
+		return cfg
+	}
+
+	cfg := l.cfg
+	if key != "" {
+		for idx := range strings.SplitSeq(key, ",") {
+			i, _ := strconv.Atoi(idx)
+			cfg = cfg.withOverride(l.cfg.Overrides[i])
+		}
+		// Каждое переопределение проверено в newLinter, а их сочетание
+		// только объединяет списки, так что ошибок здесь не бывает.
+		cfg, _ = cfg.compile()
+	}
+	if l.configs == nil {
+		l.configs = make(map[string]Config)
+	}
+	l.configs[key] = cfg
+	return cfg
+}
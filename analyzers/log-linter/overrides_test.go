@@ -0,0 +1,117 @@
+package analyzer
+
+import (
+	"slices"
+	"testing"
+)
+
+// ---------- TestMatchPackage ----------
+
+func TestMatchPackage(t *testing.T) {
+	tests := []struct {
+		pattern string
+		pkg     string
+		want    bool
+	}{
+		{pattern: "example.com/app/internal/auth", pkg: "example.com/app/internal/auth", want: true},
+		{pattern: "example.com/app/internal/auth", pkg: "example.com/app/internal/authz"},
+		{pattern: "example.com/app/cmd/*", pkg: "example.com/app/cmd/tool", want: true},
+		{pattern: "example.com/app/cmd/*", pkg: "example.com/app/cmd/tool/flags"},
+		{pattern: "example.com/app/cmd/...", pkg: "example.com/app/cmd", want: true},
+		{pattern: "example.com/app/cmd/...", pkg: "example.com/app/cmd/tool/flags", want: true},
+		{pattern: "example.com/app/cmd/...", pkg: "example.com/app/cmdline"},
+		{pattern: "example.com/app/cmd/...", pkg: "example.com/app"},
+		{pattern: "*/app/...", pkg: "example.com/app/internal", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.pkg, func(t *testing.T) {
+			if got := matchPackage([]string{tt.pattern}, tt.pkg); got != tt.want {
+				t.Errorf("matchPackage(%q, %q) = %v, want %v", tt.pattern, tt.pkg, got, tt.want)
+			}
+		})
+	}
+}
+
+// ---------- TestMatchFile ----------
+
+func TestMatchFile(t *testing.T) {
+	tests := []struct {
+		pattern string
+		file    string
+		want    bool
+	}{
+		{pattern: "*_gen.go", file: "/src/app/models_gen.go", want: true},
+		{pattern: "*_gen.go", file: "/src/app/models.go"},
+		{pattern: "cmd/*/main.go", file: "/src/app/cmd/tool/main.go", want: true},
+		{pattern: "cmd/*/main.go", file: "/src/app/main.go"},
+		{pattern: "/src/app/*.go", file: "/src/app/main.go", want: true},
+		{pattern: "/src/app/*.go", file: "/other/src/app/main.go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.file, func(t *testing.T) {
+			if got := matchFile([]string{tt.pattern}, tt.file); got != tt.want {
+				t.Errorf("matchFile(%q, %q) = %v, want %v", tt.pattern, tt.file, got, tt.want)
+			}
+		})
+	}
+}
+
+// ---------- TestWithOverride ----------
+
+func TestWithOverride(t *testing.T) {
+	off := false
+	base := Config{
+		SensitivePatterns:  []string{"token"},
+		AllowedPunctuation: ":",
+		Rules:              map[string]RuleConfig{RuleLowercase: {Severity: SeverityWarning}},
+	}
+	got := base.withOverride(Override{
+		SensitivePatterns:  []string{"session"},
+		AllowedPunctuation: "!",
+		Rules:              map[string]RuleConfig{RuleLowercase: {Enabled: &off}},
+	})
+
+	if want := []string{"token", "session"}; !slices.Equal(got.SensitivePatterns, want) {
+		t.Errorf("SensitivePatterns = %q, want %q", got.SensitivePatterns, want)
+	}
+	if got.AllowedPunctuation != ":!" {
+		t.Errorf("AllowedPunctuation = %q, want %q", got.AllowedPunctuation, ":!")
+	}
+	if got.ruleEnabled(RuleLowercase) {
+		t.Error("lowercase rule is enabled, want disabled")
+	}
+	if sev := got.Rules[RuleLowercase].Severity; sev != SeverityWarning {
+		t.Errorf("Severity = %q, want %q", sev, SeverityWarning)
+	}
+	if _, ok := base.Rules[RuleLowercase]; !ok || base.Rules[RuleLowercase].Enabled != nil {
+		t.Error("withOverride modified the base rules")
+	}
+}
+
+// ---------- TestConfigValidateOverrides ----------
+
+func TestConfigValidateOverrides(t *testing.T) {
+	tests := []struct {
+		name     string
+		override Override
+		wantErr  bool
+	}{
+		{name: "valid", override: Override{Packages: []string{"example.com/app/cmd/..."}, AllowedPunctuation: "!"}},
+		{name: "no patterns", override: Override{AllowedPunctuation: "!"}, wantErr: true},
+		{name: "bad package pattern", override: Override{Packages: []string{"example.com/[app"}}, wantErr: true},
+		{name: "bad file pattern", override: Override{Files: []string{"[*.go"}}, wantErr: true},
+		{name: "unknown rule", override: Override{Files: []string{"*.go"}, Rules: map[string]RuleConfig{"charset": {}}}, wantErr: true},
+		{name: "unknown script", override: Override{Files: []string{"*.go"}, AllowedScripts: []string{"Klingon"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Config{Overrides: []Override{tt.override}}.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
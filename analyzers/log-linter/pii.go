@@ -0,0 +1,163 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// defaultPIIPatterns — паттерны имён персональных данных по умолчанию.
+var defaultPIIPatterns = []string{
+	"email",
+	"phone",
+	"ssn",
+	"passport",
+	"iban",
+	"cardnumber",
+	"birthdate",
+	"dob",
+}
+
+// piiFormat — формат персональных данных в строковом литерале.
+type piiFormat struct {
+	name string
+	re   *regexp.Regexp
+	// valid дополнительно проверяет найденную подстроку; nil означает,
+	// что достаточно совпадения с re.
+	valid func(string) bool
+}
+
+// piiFormats — форматы персональных данных, которые ищутся в строковых
+// литералах. IBAN проверяется раньше номера карты: цифры IBAN сами по себе
+// похожи на номер карты.
+var piiFormats = []piiFormat{
+	{name: "email address", re: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`)},
+	{name: "IBAN", re: regexp.MustCompile(`\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,3})?\b`), valid: ibanValid},
+	{name: "card number", re: regexp.MustCompile(`\b[2-6]\d{3}(?:[ -]?\d){9,15}\b`), valid: luhnValid},
+	{name: "phone number", re: regexp.MustCompile(`\+\d[\d ().-]{8,}\d|\(\d{3}\) ?\d{3}-\d{4}|\b\d{3}-\d{3}-\d{4}\b`), valid: phoneValid},
+}
+
+// findPII ищет в строке персональные данные известного формата
+// и возвращает название найденного.
+func findPII(s string) (string, bool) {
+	for _, f := range piiFormats {
+		for _, m := range f.re.FindAllString(s, -1) {
+			if f.valid == nil || f.valid(m) {
+				return f.name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// digits возвращает цифры строки, отбрасывая остальные символы.
+func digits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// luhnValid сообщает, проходит ли номер проверку по алгоритму Луна.
+// Разделители (пробелы, дефисы) игнорируются.
+func luhnValid(s string) bool {
+	d := digits(s)
+	if len(d) < 13 || len(d) > 19 {
+		return false
+	}
+	sum := 0
+	for i := range len(d) {
+		n := int(d[len(d)-1-i] - '0')
+		if i%2 == 1 {
+			n *= 2
+			if n > 9 {
+				n -= 9
+			}
+		}
+		sum += n
+	}
+	return sum%10 == 0
+}
+
+// ibanValid сообщает, является ли строка корректным IBAN: длина от 15 до
+// 34 символов и остаток 1 от деления на 97 (ISO 13616).
+func ibanValid(s string) bool {
+	s = strings.ReplaceAll(s, " ", "")
+	if len(s) < 15 || len(s) > 34 {
+		return false
+	}
+	var b strings.Builder
+	for _, r := range s[4:] + s[:4] {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r >= 'A' && r <= 'Z':
+			b.WriteString(strconv.Itoa(int(r - 'A' + 10)))
+		default:
+			return false
+		}
+	}
+	n, ok := new(big.Int).SetString(b.String(), 10)
+	return ok && new(big.Int).Mod(n, big.NewInt(97)).Int64() == 1
+}
+
+// phoneValid сообщает, содержит ли номер телефона от 10 до 15 цифр
+// (ограничение E.164).
+func phoneValid(s string) bool {
+	n := len(digits(s))
+	return n >= 10 && n <= 15
+}
+
+// checkPIILiteral проверяет, не содержит ли строковая константа —
+// сообщение или значение поля (what) — персональные данные.
+func checkPIILiteral(pass *analysis.Pass, expr ast.Expr, what string) {
+	text, ok := getStringValue(pass, expr)
+	if !ok {
+		return
+	}
+	name, ok := findPII(text)
+	if !ok {
+		return
+	}
+
+	reportf(pass, RulePII, expr.Pos(), "%s contains personal data: %s", what, name)
+}
+
+// checkPIIData проверяет, не попадает ли в лог-сообщение значение
+// с именем персональных данных (см. isSensitiveExpr и messageOperands).
+func checkPIIData(pass *analysis.Pass, expr ast.Expr, names *nameMatcher) {
+	for _, operand := range messageOperands(pass, expr) {
+		if isSensitiveExpr(pass, operand, names) {
+			reportf(pass, RulePII, operandPos(expr, operand),
+				"potentially personal data %q is concatenated into log message",
+				types.ExprString(operand),
+			)
+		}
+	}
+}
+
+// checkPIIField проверяет поле на персональные данные: ключ, значение
+// и строковую константу в значении. Как и checkField, сообщает не больше
+// одного нарушения на поле.
+func checkPIIField(pass *analysis.Pass, f field, names *nameMatcher) {
+	if key, ok := getStringValue(pass, f.key); ok && names.match(key) {
+		reportf(pass, RulePII, f.key.Pos(), "potentially personal data key %q is passed to logger", key)
+		return
+	}
+	if f.value == nil {
+		return
+	}
+	if value := ast.Unparen(f.value); isSensitiveExpr(pass, value, names) {
+		reportf(pass, RulePII, value.Pos(), "potentially personal data %q is passed to logger", types.ExprString(value))
+		return
+	}
+	checkPIILiteral(pass, f.value, "field value")
+}
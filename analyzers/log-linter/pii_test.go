@@ -0,0 +1,84 @@
+package analyzer
+
+import "testing"
+
+// ---------- TestFindPII ----------
+
+func TestFindPII(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		wantName string
+	}{
+		{name: "email", s: "sent to john.doe+news@mail.example.org", wantName: "email address"},
+		{name: "international phone", s: "call +44 20 7946 0958", wantName: "phone number"},
+		{name: "us phone", s: "call (555) 010-9999", wantName: "phone number"},
+		{name: "dashed phone", s: "call 555-010-9999", wantName: "phone number"},
+		{name: "iban", s: "GB82 WEST 1234 5698 7654 32", wantName: "IBAN"},
+		{name: "compact iban", s: "DE89370400440532013000", wantName: "IBAN"},
+		{name: "visa", s: "4111-1111-1111-1111", wantName: "card number"},
+		{name: "amex", s: "378282246310005", wantName: "card number"},
+		{name: "luhn invalid", s: "4111 1111 1111 1112"},
+		{name: "iban checksum invalid", s: "GB00 WEST 1234 5698 7654 32"},
+		{name: "timestamp", s: "at 2024-01-02 10:11:12"},
+		{name: "unix millis", s: "at 1700000000000"},
+		{name: "short plus number", s: "retry +1 attempt"},
+		{name: "format verbs", s: "%s@%s"},
+		{name: "plain message", s: "user logged in"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := findPII(tt.s)
+			if ok != (tt.wantName != "") || got != tt.wantName {
+				t.Errorf("findPII(%q) = %q, %v, want %q", tt.s, got, ok, tt.wantName)
+			}
+		})
+	}
+}
+
+// ---------- TestLuhnValid ----------
+
+func TestLuhnValid(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{s: "4111111111111111", want: true},
+		{s: "5500 0000 0000 0004", want: true},
+		{s: "4111111111111112"},
+		{s: "0"},
+		{s: "4111111111"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := luhnValid(tt.s); got != tt.want {
+				t.Errorf("luhnValid(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+// ---------- TestIBANValid ----------
+
+func TestIBANValid(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{s: "DE89 3704 0044 0532 0130 00", want: true},
+		{s: "FR1420041010050500013M02606", want: true},
+		{s: "DE89 3704 0044 0532 0130 01"},
+		{s: "DE89 3704"},
+		{s: "de89370400440532013000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := ibanValid(tt.s); got != tt.want {
+				t.Errorf("ibanValid(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}
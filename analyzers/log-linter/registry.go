@@ -0,0 +1,260 @@
+package analyzer
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// logrusLevels — уровни логирования logrus. Каждый из них доступен
+// также в вариантах *f и *ln.
+var logrusLevels = []string{
+	"Trace", "Debug", "Info", "Print", "Warn", "Warning", "Error", "Fatal", "Panic",
+}
+
+// zapSugarLevels — уровни логирования zap.SugaredLogger. Каждый из них
+// доступен также в вариантах *f, *w и *ln.
+var zapSugarLevels = []string{"Debug", "Info", "Warn", "Error", "DPanic", "Panic", "Fatal"}
+
+// stdLogLevels — функции пакета log и методы log.Logger. Каждая из них
+// доступна также в вариантах *f и *ln.
+var stdLogLevels = []string{"Print", "Fatal", "Panic"}
+
+// defaultLoggers — встроенные логгеры, подлежащие линту.
+//
+//   - log/slog: Info, Debug, Warn, Error, Fatal, их варианты *Context,
+//     а также Log и LogAttrs с явным уровнем
+//   - go.uber.org/zap: Info, Debug, Warn, Error, DPanic, Panic, Fatal, Log;
+//     у SugaredLogger — те же уровни в вариантах *f, *w и *ln
+//   - github.com/sirupsen/logrus: Trace, Debug, Info, Print, Warn, Warning,
+//     Error, Fatal, Panic, Log и их варианты *f и *ln — как у пакета, так и у
+//     Logger, Entry и FieldLogger
+//   - log: Print, Fatal, Panic и их варианты *f и *ln — как у пакета,
+//     так и у log.Logger
+//   - github.com/rs/zerolog: Print и Printf — как у zerolog.Logger, так и
+//     у пакета zerolog/log
+//
+// Кроме того, проверяются поля, прикрепляемые к логгеру заранее:
+// slog With, zap With/WithLazy, logrus WithField/WithFields.
+//
+// Цепочки zerolog разбираются отдельно, см. parseZerologChain.
+var defaultLoggers = []LoggerSpec{
+	{
+		Package:       "log/slog",
+		Methods:       []string{"Info", "Debug", "Warn", "Error", "Fatal"},
+		KeyValueIndex: 1,
+	},
+	{
+		Package:       "log/slog",
+		Methods:       []string{"InfoContext", "DebugContext", "WarnContext", "ErrorContext"},
+		MessageIndex:  1,
+		KeyValueIndex: 2,
+	},
+	{
+		Package:       "log/slog",
+		Methods:       []string{"Log", "LogAttrs"},
+		MessageIndex:  2,
+		KeyValueIndex: 3,
+	},
+	{
+		Package:      "log/slog",
+		Methods:      []string{"With"},
+		MessageIndex: -1,
+	},
+	{
+		Package:       "go.uber.org/zap",
+		Methods:       []string{"Info", "Debug", "Warn", "Error", "DPanic", "Panic", "Fatal"},
+		KeyValueIndex: 1,
+	},
+	{
+		Package:       "go.uber.org/zap",
+		Methods:       []string{"Log"},
+		MessageIndex:  1,
+		KeyValueIndex: 2,
+	},
+	{
+		Package:      "go.uber.org/zap",
+		Methods:      []string{"With", "WithLazy"},
+		MessageIndex: -1,
+	},
+	{
+		Package:  "go.uber.org/zap",
+		Receiver: "SugaredLogger",
+		Methods:  withSuffixes(zapSugarLevels, "", "ln"),
+	},
+	{
+		Package:  "go.uber.org/zap",
+		Receiver: "SugaredLogger",
+		Methods:  withSuffixes(zapSugarLevels, "f"),
+		Format:   true,
+	},
+	{
+		Package:       "go.uber.org/zap",
+		Receiver:      "SugaredLogger",
+		Methods:       withSuffixes(zapSugarLevels, "w"),
+		KeyValueIndex: 1,
+	},
+	{
+		Package:      "go.uber.org/zap",
+		Receiver:     "SugaredLogger",
+		Methods:      []string{"Log", "Logln"},
+		MessageIndex: 1,
+	},
+	{
+		Package:      "go.uber.org/zap",
+		Receiver:     "SugaredLogger",
+		Methods:      []string{"Logf"},
+		MessageIndex: 1,
+		Format:       true,
+	},
+	{
+		Package:       "go.uber.org/zap",
+		Receiver:      "SugaredLogger",
+		Methods:       []string{"Logw"},
+		MessageIndex:  1,
+		KeyValueIndex: 2,
+	},
+	{
+		Package:      "go.uber.org/zap",
+		Receiver:     "SugaredLogger",
+		Methods:      []string{"With", "WithLazy"},
+		MessageIndex: -1,
+	},
+	{
+		Package: "github.com/sirupsen/logrus",
+		Methods: withSuffixes(logrusLevels, "", "ln"),
+	},
+	{
+		Package: "github.com/sirupsen/logrus",
+		Methods: withSuffixes(logrusLevels, "f"),
+		Format:  true,
+	},
+	{
+		Package:      "github.com/sirupsen/logrus",
+		Methods:      []string{"Log", "Logln"},
+		MessageIndex: 1,
+	},
+	{
+		Package:      "github.com/sirupsen/logrus",
+		Methods:      []string{"Logf"},
+		MessageIndex: 1,
+		Format:       true,
+	},
+	{
+		Package:      "github.com/sirupsen/logrus",
+		Methods:      []string{"WithField", "WithFields"},
+		MessageIndex: -1,
+	},
+	{
+		Package: "log",
+		Methods: withSuffixes(stdLogLevels, "", "ln"),
+	},
+	{
+		Package: "log",
+		Methods: withSuffixes(stdLogLevels, "f"),
+		Format:  true,
+	},
+	{
+		Package:  zerologPath,
+		Receiver: "Logger",
+		Methods:  []string{"Print"},
+	},
+	{
+		Package:  zerologPath,
+		Receiver: "Logger",
+		Methods:  []string{"Printf"},
+		Format:   true,
+	},
+	{
+		Package: zerologLogPath,
+		Methods: []string{"Print"},
+	},
+	{
+		Package: zerologLogPath,
+		Methods: []string{"Printf"},
+		Format:  true,
+	},
+}
+
+// logFunc описывает расположение аргументов в вызове метода логгера.
+type logFunc struct {
+	// msg — индекс аргумента-сообщения; -1 означает, что сообщения нет
+	// (With и подобные методы, прикрепляющие поля к логгеру).
+	msg int
+	// kv — индекс первого аргумента ключ-значение (атрибуты, поля);
+	// значение, не превышающее msg, означает, что их нет.
+	kv int
+	// format — сообщение является printf-подобной строкой формата.
+	format bool
+}
+
+// hasMsg сообщает, есть ли у метода сообщение.
+func (f logFunc) hasMsg() bool {
+	return f.msg >= 0
+}
+
+// hasKV сообщает, есть ли у метода пары ключ-значение.
+func (f logFunc) hasKV() bool {
+	return f.kv > f.msg
+}
+
+// loggerKey определяет логгер: путь пакета и, опционально, имя типа
+// получателя. Пустое имя типа означает функции пакета и любые его типы,
+// для которых нет отдельной записи.
+type loggerKey struct {
+	pkg  string
+	recv string
+}
+
+// registry — методы логгеров, подлежащие линту.
+type registry map[loggerKey]map[string]logFunc
+
+// newRegistry строит реестр из описаний логгеров. При совпадении
+// логгера и метода более поздняя запись перекрывает более раннюю.
+func newRegistry(specs []LoggerSpec) registry {
+	r := make(registry)
+	for _, spec := range specs {
+		key := loggerKey{pkg: spec.Package, recv: spec.Receiver}
+		methods, ok := r[key]
+		if !ok {
+			methods = make(map[string]logFunc)
+			r[key] = methods
+		}
+		for _, name := range spec.Methods {
+			methods[name] = logFunc{
+				msg:    spec.MessageIndex,
+				kv:     spec.KeyValueIndex,
+				format: spec.Format,
+			}
+		}
+	}
+	return r
+}
+
+// Определяет родительский пакет логгера, а также вызванный у него метод.
+// На основе этого принимается решение, линтить ли вызов или нет, и
+// возвращается описание аргументов метода.
+//
+// Метод ищется сначала в записи типа получателя, затем в записи пакета:
+// запись типа дополняет и перекрывает запись пакета, но не заменяет её.
+func (r registry) isLinted(pass *analysis.Pass, expr *ast.SelectorExpr) (logFunc, bool) {
+	pkg, recv := getMethodReceiver(pass, expr)
+	if recv != "" {
+		if fn, ok := r[loggerKey{pkg: pkg, recv: recv}][expr.Sel.Name]; ok {
+			return fn, true
+		}
+	}
+	fn, ok := r[loggerKey{pkg: pkg}][expr.Sel.Name]
+	return fn, ok
+}
+
+// withSuffixes возвращает имена со всеми переданными суффиксами.
+func withSuffixes(names []string, suffixes ...string) []string {
+	out := make([]string, 0, len(names)*len(suffixes))
+	for _, name := range names {
+		for _, suffix := range suffixes {
+			out = append(out, name+suffix)
+		}
+	}
+	return out
+}
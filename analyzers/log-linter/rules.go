@@ -0,0 +1,258 @@
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Идентификаторы правил. Идентификатор записывается в Category каждой
+// диагностики и префиксом [id] в её сообщение, чтобы диагностики можно
+// было фильтровать и настраивать по отдельности (см. Config.Rules).
+const (
+	// RuleLowercase — сообщение начинается со строчной буквы.
+	RuleLowercase = "lowercase"
+	// RuleLatinOnly — сообщение содержит только латинские буквы.
+	RuleLatinOnly = "latin-only"
+	// RuleSpecialSymbols — сообщение не содержит спецсимволов.
+	RuleSpecialSymbols = "special-symbols"
+	// RuleSensitiveData — в лог не попадают данные с чувствительными
+	// именами, структуры с секретными полями и (в режиме Taint) значения,
+	// полученные из секретных источников.
+	RuleSensitiveData = "sensitive-data"
+	// RuleHardcodedSecret — сообщения и значения полей не содержат
+	// секретов, вставленных прямо в код.
+	RuleHardcodedSecret = "hardcoded-secret"
+	// RulePII — в лог не попадают персональные данные. Выключено по
+	// умолчанию, см. Config.PII.
+	RulePII = "pii"
+	// RuleFormatArgs — число аргументов совпадает со строкой формата.
+	RuleFormatArgs = "format-args"
+	// RuleMessagePattern — сообщение соответствует Config.MessagePattern.
+	// Включено, только если шаблон задан.
+	RuleMessagePattern = "message-pattern"
+	// RuleIgnoreDirective — директивы loglinter:ignore и
+	// loglinter:file-ignore корректны, содержат причину и используются.
+	RuleIgnoreDirective = "ignore-directive"
+)
+
+// rule — правило линтера: идентификатор и проверка собранных вызовов
+// логгеров. Каждое правило доступно отдельным анализатором (см.
+// NewAnalyzers).
+type rule struct {
+	id  string
+	doc string
+	run func(pass *analysis.Pass, calls *logCalls, cfg Config)
+}
+
+// rules — все правила в порядке проверки.
+var rules = []rule{
+	{id: RuleLowercase, doc: "checks that log messages start with a lowercase letter", run: runLowercase},
+	{id: RuleLatinOnly, doc: "checks that log messages contain only latin letters", run: runCharset},
+	{id: RuleSpecialSymbols, doc: "checks that log messages contain no special symbols", run: runCharset},
+	{id: RuleSensitiveData, doc: "checks that sensitive data does not reach loggers", run: runSensitiveData},
+	{id: RuleHardcodedSecret, doc: "checks that log messages and fields contain no hardcoded secrets", run: runHardcodedSecret},
+	{id: RulePII, doc: "checks that personal data does not reach loggers", run: runPII},
+	{id: RuleFormatArgs, doc: "checks that printf-like logger calls match their format", run: runFormatArgs},
+	{id: RuleMessagePattern, doc: "checks that log messages match the configured pattern", run: runMessagePattern},
+	{id: RuleIgnoreDirective, doc: "checks loglinter:ignore directives for reasons and unused suppressions", run: runIgnoreDirective},
+}
+
+// isRule сообщает, есть ли правило с идентификатором id.
+func isRule(id string) bool {
+	return slices.ContainsFunc(rules, func(r rule) bool { return r.id == id })
+}
+
+// analyzerName возвращает имя анализатора правила: идентификатор,
+// в котором дефисы заменены подчёркиваниями.
+func (r rule) analyzerName() string {
+	return strings.ReplaceAll(r.id, "-", "_")
+}
+
+func runLowercase(pass *analysis.Pass, calls *logCalls, _ Config) {
+	for _, c := range calls.calls {
+		if msg := c.message(); msg != nil {
+			checkStartsWithUpper(pass, msg)
+		}
+	}
+}
+
+// runCharset проверяет символы сообщений; лишние диагностики отбрасывает
+// rulePass, так что одна функция обслуживает RuleLatinOnly
+// и RuleSpecialSymbols.
+func runCharset(pass *analysis.Pass, calls *logCalls, cfg Config) {
+	for _, c := range calls.calls {
+		if msg := c.message(); msg != nil {
+			checkNotAllowedSymbols(pass, msg, c.fn.format, cfg.charset)
+		}
+	}
+}
+
+func runSensitiveData(pass *analysis.Pass, calls *logCalls, cfg Config) {
+	for _, c := range calls.calls {
+		if msg := c.message(); msg != nil {
+			checkSensitiveData(pass, msg, cfg.names)
+		}
+		for _, v := range c.values {
+			checkSensitiveType(pass, v, cfg)
+		}
+		for _, f := range c.fields {
+			checkField(pass, f, cfg)
+		}
+	}
+	if cfg.Taint && len(calls.sinks) > 0 {
+		checkTaint(pass, calls.sinks, calls.ssa, cfg)
+	}
+}
+
+func runHardcodedSecret(pass *analysis.Pass, calls *logCalls, _ Config) {
+	for _, c := range calls.calls {
+		if msg := c.message(); msg != nil {
+			checkHardcodedSecret(pass, msg, "log message")
+		}
+		for _, f := range c.fields {
+			if f.value != nil {
+				checkHardcodedSecret(pass, f.value, "field value")
+			}
+		}
+	}
+}
+
+func runPII(pass *analysis.Pass, calls *logCalls, cfg Config) {
+	for _, c := range calls.calls {
+		if msg := c.message(); msg != nil {
+			checkPIIData(pass, msg, cfg.piiNames)
+			checkPIILiteral(pass, msg, "log message")
+		}
+		for _, f := range c.fields {
+			checkPIIField(pass, f, cfg.piiNames)
+		}
+	}
+}
+
+func runFormatArgs(pass *analysis.Pass, calls *logCalls, _ Config) {
+	for _, c := range calls.calls {
+		if c.fn.format {
+			checkFormatArgs(pass, c.call, c.fn.msg)
+		}
+	}
+}
+
+func runMessagePattern(pass *analysis.Pass, calls *logCalls, cfg Config) {
+	if cfg.messagePattern == nil {
+		return
+	}
+	for _, c := range calls.calls {
+		if msg := c.message(); msg != nil {
+			checkMessagePattern(pass, msg, cfg.messagePattern)
+		}
+	}
+}
+
+func runIgnoreDirective(pass *analysis.Pass, calls *logCalls, cfg Config) {
+	checkIgnores(pass, calls.ignores)
+	reportUnusedIgnores(pass, calls.ignores, calls.used, cfg)
+}
+
+// Severity — уровень диагностик правила.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// RuleConfig — настройки отдельного правила.
+type RuleConfig struct {
+	// Enabled включает или выключает правило; nil означает значение по
+	// умолчанию: включены все правила, кроме pii и message-pattern.
+	Enabled *bool
+	// Severity — уровень диагностик правила. Анализатор не может сам
+	// задать уровень для golangci-lint, поэтому уровень добавляется
+	// в сообщение после идентификатора правила ("[pii] warning: ...")
+	// и может использоваться в severity.rules. Пустое значение — без уровня.
+	Severity Severity
+}
+
+func (r RuleConfig) validate() error {
+	switch r.Severity {
+	case "", SeverityError, SeverityWarning, SeverityInfo:
+		return nil
+	}
+	return fmt.Errorf("unknown severity %q", r.Severity)
+}
+
+// validateRules проверяет настройки правил.
+func (c Config) validateRules() error {
+	var errs []error
+	for id, rc := range c.Rules {
+		if !isRule(id) {
+			errs = append(errs, fmt.Errorf("unknown rule %q", id))
+			continue
+		}
+		if err := rc.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("rules[%s]: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ruleEnabled сообщает, включено ли правило.
+func (c Config) ruleEnabled(id string) bool {
+	if rc, ok := c.Rules[id]; ok && rc.Enabled != nil {
+		return *rc.Enabled
+	}
+	switch id {
+	case RulePII:
+		return c.PII
+	case RuleMessagePattern:
+		return c.MessagePattern != ""
+	}
+	return true
+}
+
+// ruleMessage добавляет к сообщению идентификатор правила и, если он
+// задан, уровень.
+func (c Config) ruleMessage(id, msg string) string {
+	if sev := c.Rules[id].Severity; sev != "" {
+		return fmt.Sprintf("[%s] %s: %s", id, sev, msg)
+	}
+	return fmt.Sprintf("[%s] %s", id, msg)
+}
+
+// rulePass возвращает копию pass, которая пропускает только диагностики
+// правила id (по Category), не подавленные директивами ignores,
+// и добавляет к их сообщениям идентификатор правила (см. ruleMessage).
+// Сработавшие директивы отмечаются в used.
+func (c Config) rulePass(pass *analysis.Pass, id string, ignores []*ignore, used usedIgnores) *analysis.Pass {
+	p := *pass
+	p.Report = func(d analysis.Diagnostic) {
+		if d.Category != id {
+			return
+		}
+		pos := pass.Fset.Position(d.Pos)
+		for _, ig := range ignores {
+			if ig.suppresses(id, pos) {
+				used[ig] = true
+				return
+			}
+		}
+		d.Message = c.ruleMessage(id, d.Message)
+		pass.Report(d)
+	}
+	return &p
+}
+
+// reportf сообщает о нарушении правила id.
+func reportf(pass *analysis.Pass, id string, pos token.Pos, format string, args ...any) {
+	pass.Report(analysis.Diagnostic{
+		Pos:      pos,
+		Category: id,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
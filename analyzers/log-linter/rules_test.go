@@ -0,0 +1,68 @@
+package analyzer
+
+import "testing"
+
+// ---------- TestRuleEnabled ----------
+
+func TestRuleEnabled(t *testing.T) {
+	on, off := true, false
+	tests := []struct {
+		name string
+		cfg  Config
+		rule string
+		want bool
+	}{
+		{name: "enabled by default", rule: RuleLowercase, want: true},
+		{name: "pii disabled by default", rule: RulePII},
+		{name: "pii via flag", cfg: Config{PII: true}, rule: RulePII, want: true},
+		{name: "pii via rules", cfg: Config{Rules: map[string]RuleConfig{RulePII: {Enabled: &on}}}, rule: RulePII, want: true},
+		{name: "rules override flag", cfg: Config{PII: true, Rules: map[string]RuleConfig{RulePII: {Enabled: &off}}}, rule: RulePII},
+		{name: "disabled", cfg: Config{Rules: map[string]RuleConfig{RuleLatinOnly: {Enabled: &off}}}, rule: RuleLatinOnly},
+		{name: "severity only", cfg: Config{Rules: map[string]RuleConfig{RuleLatinOnly: {Severity: SeverityInfo}}}, rule: RuleLatinOnly, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.ruleEnabled(tt.rule); got != tt.want {
+				t.Errorf("ruleEnabled(%q) = %v, want %v", tt.rule, got, tt.want)
+			}
+		})
+	}
+}
+
+// ---------- TestRuleMessage ----------
+
+func TestRuleMessage(t *testing.T) {
+	cfg := Config{Rules: map[string]RuleConfig{RuleSensitiveData: {Severity: SeverityError}}}
+
+	if got, want := cfg.ruleMessage(RuleLowercase, "msg"), "[lowercase] msg"; got != want {
+		t.Errorf("ruleMessage() = %q, want %q", got, want)
+	}
+	if got, want := cfg.ruleMessage(RuleSensitiveData, "msg"), "[sensitive-data] error: msg"; got != want {
+		t.Errorf("ruleMessage() = %q, want %q", got, want)
+	}
+}
+
+// ---------- TestConfigValidateRules ----------
+
+func TestConfigValidateRules(t *testing.T) {
+	off := false
+	tests := []struct {
+		name    string
+		rules   map[string]RuleConfig
+		wantErr bool
+	}{
+		{name: "valid", rules: map[string]RuleConfig{RuleLatinOnly: {Enabled: &off}, RulePII: {Severity: SeverityWarning}}},
+		{name: "unknown rule", rules: map[string]RuleConfig{"charset": {Enabled: &off}}, wantErr: true},
+		{name: "unknown severity", rules: map[string]RuleConfig{RuleLowercase: {Severity: "fatal"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Config{Rules: tt.rules}.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
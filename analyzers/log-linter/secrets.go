@@ -0,0 +1,96 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// secretFormat — известный формат секрета.
+type secretFormat struct {
+	name string
+	re   *regexp.Regexp
+}
+
+// secretFormats — известные форматы секретов, которые ищутся в строковых
+// литералах.
+var secretFormats = []secretFormat{
+	{name: "AWS access key", re: regexp.MustCompile(`\b(?:AKIA|ASIA|AGPA|AIDA|AROA|ANPA|ANVA|AIPA)[0-9A-Z]{16}\b`)},
+	{name: "JWT", re: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{5,}\.eyJ[A-Za-z0-9_-]{5,}\.[A-Za-z0-9_-]{10,}`)},
+	{name: "GitHub token", re: regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`)},
+	{name: "private key", re: regexp.MustCompile(`-----BEGIN (?:[A-Z0-9]+ )*PRIVATE KEY-----`)},
+	{name: "bearer token", re: regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9._~+/-]{16,}=*`)},
+}
+
+// Параметры поиска строк с высокой энтропией: кандидат — непрерывная
+// последовательность символов base64 не короче minSecretLength, в которой
+// есть и буквы, и цифры. Порог выше максимальной энтропии
+// шестнадцатеричной строки (4 бита на символ), поэтому хеши и
+// идентификаторы коммитов не считаются секретами.
+const (
+	minSecretLength  = 24
+	entropyThreshold = 4.0
+)
+
+// secretCandidate — кандидат в строки с высокой энтропией.
+var secretCandidate = regexp.MustCompile(fmt.Sprintf(`[A-Za-z0-9+/=_]{%d,}`, minSecretLength))
+
+// findSecret ищет в строке секрет известного формата или строку
+// с высокой энтропией и возвращает название найденного.
+func findSecret(s string) (string, bool) {
+	for _, f := range secretFormats {
+		if f.re.MatchString(s) {
+			return f.name, true
+		}
+	}
+	for _, c := range secretCandidate.FindAllString(s, -1) {
+		if hasLettersAndDigits(c) && shannonEntropy(c) > entropyThreshold {
+			return "high-entropy string", true
+		}
+	}
+	return "", false
+}
+
+// hasLettersAndDigits сообщает, есть ли в строке и буквы, и цифры.
+func hasLettersAndDigits(s string) bool {
+	return strings.ContainsFunc(s, unicode.IsLetter) && strings.ContainsFunc(s, unicode.IsDigit)
+}
+
+// shannonEntropy возвращает энтропию Шеннона строки в битах на символ.
+func shannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	counts := make(map[rune]int)
+	n := 0
+	for _, r := range s {
+		counts[r]++
+		n++
+	}
+	var h float64
+	for _, c := range counts {
+		p := float64(c) / float64(n)
+		h -= p * math.Log2(p)
+	}
+	return h
+}
+
+// checkHardcodedSecret проверяет, не содержит ли строковая константа —
+// сообщение или значение поля (what) — секрет, вставленный прямо в код.
+func checkHardcodedSecret(pass *analysis.Pass, expr ast.Expr, what string) {
+	text, ok := getStringValue(pass, expr)
+	if !ok {
+		return
+	}
+	name, ok := findSecret(text)
+	if !ok {
+		return
+	}
+
+	reportf(pass, RuleHardcodedSecret, expr.Pos(), "%s contains hardcoded secret: %s", what, name)
+}
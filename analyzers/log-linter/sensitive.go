@@ -0,0 +1,276 @@
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// sensitiveGetters — методы, возвращающие значение по строковому ключу
+// (имени заголовка, параметра формы и т.п.). Вызов такого метода с
+// чувствительным ключом считается ссылкой на секретные данные:
+// req.Header.Get("Authorization"), r.FormValue("password").
+var sensitiveGetters = map[string]bool{
+	"net/http.Header.Get":            true,
+	"net/http.Header.Values":         true,
+	"net/http.Request.FormValue":     true,
+	"net/http.Request.PostFormValue": true,
+	"net/url.Values.Get":             true,
+}
+
+// sensitiveHeaders — заголовки, которые несут учётные данные, хотя их
+// имена не совпадают с паттернами.
+var sensitiveHeaders = mustNameMatcher("authorization", "cookie", "apikey")
+
+// isSensitiveExpr сообщает, ссылается ли выражение на потенциально
+// секретные данные:
+//   - переменную с чувствительным именем (password);
+//   - поле, любое имя в пути к которому чувствительно (user.Password,
+//     cfg.DB.Secret);
+//   - элемент отображения по константному чувствительному ключу
+//     (params["token"]);
+//   - вызов метода-геттера с чувствительным ключом
+//     (req.Header.Get("Authorization")).
+func isSensitiveExpr(pass *analysis.Pass, expr ast.Expr, names *nameMatcher) bool {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		return names.match(e.Name)
+	case *ast.SelectorExpr:
+		if names.match(e.Sel.Name) {
+			return true
+		}
+		if isPackageName(pass, e.X) {
+			return false
+		}
+		return isSensitiveExpr(pass, e.X, names)
+	case *ast.IndexExpr:
+		if pass.TypesInfo != nil && isStringMap(pass.TypesInfo.TypeOf(e.X)) {
+			if key, ok := getStringValue(pass, e.Index); ok && names.match(key) {
+				return true
+			}
+		}
+		return isSensitiveExpr(pass, e.X, names)
+	case *ast.CallExpr:
+		return isSensitiveGetter(pass, e, names)
+	}
+	return false
+}
+
+// isSensitiveGetter сообщает, является ли вызов обращением к геттеру из
+// sensitiveGetters с чувствительным константным ключом.
+func isSensitiveGetter(pass *analysis.Pass, call *ast.CallExpr, names *nameMatcher) bool {
+	if pass.TypesInfo == nil || len(call.Args) != 1 {
+		return false
+	}
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok {
+		return false
+	}
+	pkg, recv := funcReceiver(fn)
+	if recv == "" || !sensitiveGetters[pkg+"."+recv+"."+fn.Name()] {
+		return false
+	}
+	key, ok := getStringValue(pass, call.Args[0])
+	return ok && isSensitiveHeader(key, names)
+}
+
+// isSensitiveHeader сообщает, является ли имя заголовка или параметра
+// чувствительным: совпадает с паттернами или с sensitiveHeaders.
+// Для паттернов персональных данных sensitiveHeaders не применяются.
+func isSensitiveHeader(name string, names *nameMatcher) bool {
+	return names.match(name) || names != nil && !names.noHeaders && sensitiveHeaders.match(name)
+}
+
+// isPackageName сообщает, является ли выражение именем импортированного
+// пакета.
+func isPackageName(pass *analysis.Pass, expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok || pass.TypesInfo == nil {
+		return false
+	}
+	_, ok = pass.TypesInfo.Uses[ident].(*types.PkgName)
+	return ok
+}
+
+// opaqueInterfaces — интерфейсы логгеров, через которые значение само
+// определяет своё представление в логе: slog.LogValuer
+// и zapcore.ObjectMarshaler. Интерфейс ищется в пакете, на который
+// ссылается сигнатура метода типа (slog.Value, zapcore.ObjectEncoder),
+// так что импортировать пакет логгера проверяемому пакету не нужно.
+var opaqueInterfaces = []struct{ pkg, name, method string }{
+	{pkg: "log/slog", name: "LogValuer", method: "LogValue"},
+	{pkg: "go.uber.org/zap/zapcore", name: "ObjectMarshaler", method: "MarshalLogObject"},
+}
+
+// stringerType — интерфейс, тождественный fmt.Stringer: интерфейсы
+// сравниваются по набору методов, так что пакет fmt для проверки не нужен.
+var stringerType = types.NewInterfaceType([]*types.Func{
+	types.NewFunc(token.NoPos, nil, "String", types.NewSignatureType(nil, nil, nil, nil,
+		types.NewTuple(types.NewParam(token.NoPos, nil, "", types.Typ[types.String])), false)),
+}, nil).Complete()
+
+// errorType — встроенный интерфейс error.
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+// checkSensitiveType проверяет, не передаётся ли в логгер значение,
+// тип которого (транзитивно) содержит секретные поля: slog.Any("user", u)
+// выведет их все.
+func checkSensitiveType(pass *analysis.Pass, expr ast.Expr, cfg Config) {
+	typ := pass.TypesInfo.TypeOf(expr)
+	if typ == nil {
+		return
+	}
+	var module string
+	if pass.Module != nil {
+		module = pass.Module.Path
+	}
+	path, ok := findSensitiveField(typ, cfg.names, cfg.SensitiveTag, module)
+	if !ok {
+		return
+	}
+
+	reportf(pass, RuleSensitiveData, expr.Pos(), "value of type %s contains potentially sensitive field %q",
+		types.TypeString(typ, types.RelativeTo(pass.Pkg)), path)
+}
+
+// findSensitiveField обходит тип — структуры, указатели, срезы, массивы
+// и значения отображений — и возвращает путь к первому полю, имя которого
+// совпадает с паттернами или которое помечено тегом tag. Типы, сами
+// определяющие своё представление (см. isOpaqueType), и типы стандартной
+// библиотеки не обходятся; module —
+// путь текущего модуля, нужный, чтобы отличить её пакеты от пакетов
+// модуля без точки в имени.
+func findSensitiveField(typ types.Type, names *nameMatcher, tag, module string) (string, bool) {
+	seen := make(map[types.Type]bool)
+	var walk func(types.Type) (string, bool)
+	walk = func(t types.Type) (string, bool) {
+		if seen[t] || isOpaqueType(t) || isStdlibType(t, module) {
+			return "", false
+		}
+		seen[t] = true
+
+		switch u := t.Underlying().(type) {
+		case *types.Pointer:
+			return walk(u.Elem())
+		case *types.Slice:
+			return walk(u.Elem())
+		case *types.Array:
+			return walk(u.Elem())
+		case *types.Map:
+			return walk(u.Elem())
+		case *types.Struct:
+			for i := range u.NumFields() {
+				if f := u.Field(i); names.match(f.Name()) || hasSensitiveTag(u.Tag(i), tag) {
+					return f.Name(), true
+				}
+			}
+			for i := range u.NumFields() {
+				if path, ok := walk(u.Field(i).Type()); ok {
+					return u.Field(i).Name() + "." + path, true
+				}
+			}
+		}
+		return "", false
+	}
+	return walk(typ)
+}
+
+// isStdlibType сообщает, объявлен ли именованный тип в стандартной
+// библиотеке: первый элемент пути её пакетов не содержит точки, а сами
+// пакеты не принадлежат модулю module.
+func isStdlibType(typ types.Type, module string) bool {
+	named, ok := typ.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	path := named.Obj().Pkg().Path()
+	if module != "" && (path == module || strings.HasPrefix(path, module+"/")) {
+		return false
+	}
+	first, _, _ := strings.Cut(path, "/")
+	return !strings.Contains(first, ".")
+}
+
+// isOpaqueType сообщает, определяет ли значение типа typ своё
+// представление в логе само: реализует fmt.Stringer, error или один из
+// opaqueInterfaces. Учитывается набор методов самого typ, а не указателя
+// на него: fmt и логгеры не вызывают метод с указателем-получателем
+// у значения, переданного не по указателю.
+func isOpaqueType(typ types.Type) bool {
+	if types.IsInterface(typ) {
+		return false
+	}
+	if types.Implements(typ, stringerType) || types.Implements(typ, errorType) {
+		return true
+	}
+	for _, oi := range opaqueInterfaces {
+		obj, _, _ := types.LookupFieldOrMethod(typ, true, nil, oi.method)
+		fn, ok := obj.(*types.Func)
+		if !ok {
+			continue
+		}
+		pkg := signaturePackage(fn.Signature(), oi.pkg)
+		if pkg == nil {
+			continue
+		}
+		if iface, ok := pkg.Scope().Lookup(oi.name).(*types.TypeName); ok {
+			if it, ok := iface.Type().Underlying().(*types.Interface); ok && types.Implements(typ, it) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// signaturePackage возвращает пакет path, на именованные типы которого
+// ссылаются параметры или результаты сигнатуры, или nil.
+func signaturePackage(sig *types.Signature, path string) *types.Package {
+	for _, tuple := range []*types.Tuple{sig.Params(), sig.Results()} {
+		for v := range tuple.Variables() {
+			t := v.Type()
+			if ptr, ok := t.(*types.Pointer); ok {
+				t = ptr.Elem()
+			}
+			if named, ok := t.(*types.Named); ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == path {
+				return named.Obj().Pkg()
+			}
+		}
+	}
+	return nil
+}
+
+// hasSensitiveTag сообщает, содержит ли тег поля структуры тег tag
+// (например, log:"secret" совпадает с `json:"pw" log:"secret,omit"`).
+func hasSensitiveTag(fieldTag, tag string) bool {
+	key, value, err := parseSensitiveTag(tag)
+	if err != nil {
+		return false
+	}
+	opts, ok := reflect.StructTag(fieldTag).Lookup(key)
+	return ok && slices.Contains(strings.Split(opts, ","), value)
+}
+
+// parseSensitiveTag разбирает тег вида key:"value".
+func parseSensitiveTag(tag string) (key, value string, err error) {
+	key, quoted, ok := strings.Cut(tag, ":")
+	if !ok || key == "" || strings.ContainsAny(key, " \t\"") {
+		return "", "", fmt.Errorf("sensitive tag %q: want key:\"value\"", tag)
+	}
+	value, err = strconv.Unquote(quoted)
+	if err != nil || !strings.HasPrefix(quoted, `"`) {
+		return "", "", fmt.Errorf("sensitive tag %q: value must be a double-quoted string", tag)
+	}
+	if value == "" {
+		return "", "", errors.New("sensitive tag value is empty")
+	}
+	return key, value, nil
+}
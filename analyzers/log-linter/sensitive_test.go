@@ -0,0 +1,245 @@
+package analyzer
+
+import (
+	"go/types"
+	"testing"
+)
+
+// ---------- TestIsSensitiveExpr ----------
+
+func TestIsSensitiveExpr(t *testing.T) {
+	pass, args := typecheck(t, `package p
+
+import (
+	"net/http"
+	"os"
+)
+
+type db struct{ Secret, Host string }
+
+type config struct {
+	DB   db
+	Name string
+}
+
+type user struct{ Password, Login string }
+
+func log(any) {}
+
+func f(u user, cfg *config, m map[string]string, s []string, r *http.Request, token string) {
+	log(token)
+	log(u.Password)
+	log(cfg.DB.Secret)
+	log(cfg.DB.Host)
+	log(u.Login)
+	log(m["api_token"])
+	log(m["user"])
+	log(s[0])
+	log(r.Header.Get("Authorization"))
+	log(r.Header.Get("X-Api-Key"))
+	log(r.Header.Get("Content-Type"))
+	log(r.FormValue("password"))
+	log(os.Args)
+}
+`)
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{expr: "token", want: true},
+		{expr: "u.Password", want: true},
+		{expr: "cfg.DB.Secret", want: true},
+		{expr: "cfg.DB.Host"},
+		{expr: "u.Login"},
+		{expr: `m["api_token"]`, want: true},
+		{expr: `m["user"]`},
+		{expr: "s[0]"},
+		{expr: `r.Header.Get("Authorization")`, want: true},
+		{expr: `r.Header.Get("X-Api-Key")`, want: true},
+		{expr: `r.Header.Get("Content-Type")`},
+		{expr: `r.FormValue("password")`, want: true},
+		{expr: "os.Args"},
+	}
+
+	exprs := map[string]bool{}
+	for _, arg := range args {
+		exprs[types.ExprString(arg)] = isSensitiveExpr(pass, arg, mustNameMatcher(defaultSensitivePatterns...))
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, ok := exprs[tt.expr]
+			if !ok {
+				t.Fatalf("expression %s not found", tt.expr)
+			}
+			if got != tt.want {
+				t.Errorf("isSensitiveExpr(%s) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+// ---------- TestIsSensitiveHeader ----------
+
+func TestIsSensitiveHeader(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: "Authorization", want: true},
+		{name: "Proxy-Authorization", want: true},
+		{name: "X-Api-Key", want: true},
+		{name: "Cookie", want: true},
+		{name: "Set-Cookie", want: true},
+		{name: "X-Auth-Token", want: true},
+		{name: "Content-Type"},
+		{name: "User-Agent"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isSensitiveHeader(tt.name, mustNameMatcher(defaultSensitivePatterns...)); got != tt.want {
+				t.Errorf("isSensitiveHeader(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+
+	// Credential headers are not personal data.
+	cfg, err := Config{PII: true, SensitiveTag: defaultSensitiveTag}.compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if isSensitiveHeader("Authorization", cfg.piiNames) {
+		t.Error("isSensitiveHeader(Authorization) = true for pii patterns, want false")
+	}
+	if names := mustNameMatcher(defaultSensitivePatterns...); names.match("cookie") {
+		t.Error("match(cookie) = true, want false outside header getters")
+	}
+}
+
+// ---------- TestFindSensitiveField ----------
+
+func TestFindSensitiveField(t *testing.T) {
+	pass, args := typecheck(t, `package p
+
+import "time"
+
+type creds struct{ Login, Secret string }
+
+type user struct {
+	ID    int
+	Creds *creds
+}
+
+type tagged struct {
+	Session string `+"`log:\"secret\"`"+`
+}
+
+type node struct {
+	Next *node
+	Name string
+}
+
+type stringer struct{ Password string }
+
+func (stringer) String() string { return "" }
+
+func log(any) {}
+
+func f() {
+	log(user{})
+	log([]tagged{})
+	log(map[string]*creds{})
+	log(node{})
+	log(stringer{})
+	log(time.Time{})
+	log(42)
+}
+`)
+
+	tests := []struct {
+		expr     string
+		wantPath string
+	}{
+		{expr: "user{}", wantPath: "Creds.Secret"},
+		{expr: "[]tagged{}", wantPath: "Session"},
+		{expr: "map[string]*creds{}", wantPath: "Secret"},
+		{expr: "node{}"},
+		{expr: "stringer{}"},
+		{expr: "time.Time{}"},
+		{expr: "42"},
+	}
+
+	paths := map[string]string{}
+	for _, arg := range args {
+		typ := pass.TypesInfo.TypeOf(arg)
+		path, _ := findSensitiveField(typ, mustNameMatcher(defaultSensitivePatterns...), defaultSensitiveTag, "p")
+		paths[types.ExprString(arg)] = path
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, ok := paths[tt.expr]
+			if !ok {
+				t.Fatalf("expression %s not found", tt.expr)
+			}
+			if got != tt.wantPath {
+				t.Errorf("findSensitiveField(%s) = %q, want %q", tt.expr, got, tt.wantPath)
+			}
+		})
+	}
+}
+
+// ---------- TestHasSensitiveTag ----------
+
+func TestHasSensitiveTag(t *testing.T) {
+	tests := []struct {
+		fieldTag string
+		tag      string
+		want     bool
+	}{
+		{fieldTag: `log:"secret"`, tag: `log:"secret"`, want: true},
+		{fieldTag: `json:"pw" log:"secret,omit"`, tag: `log:"secret"`, want: true},
+		{fieldTag: `log:"public"`, tag: `log:"secret"`},
+		{fieldTag: `json:"secret"`, tag: `log:"secret"`},
+		{fieldTag: `mask:"true"`, tag: `mask:"true"`, want: true},
+		{fieldTag: `log:"secret"`, tag: "invalid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fieldTag+" "+tt.tag, func(t *testing.T) {
+			if got := hasSensitiveTag(tt.fieldTag, tt.tag); got != tt.want {
+				t.Errorf("hasSensitiveTag(%q, %q) = %v, want %v", tt.fieldTag, tt.tag, got, tt.want)
+			}
+		})
+	}
+}
+
+// ---------- TestParseSensitiveTag ----------
+
+func TestParseSensitiveTag(t *testing.T) {
+	tests := []struct {
+		tag       string
+		wantKey   string
+		wantValue string
+		wantErr   bool
+	}{
+		{tag: `log:"secret"`, wantKey: "log", wantValue: "secret"},
+		{tag: `sensitive:"true"`, wantKey: "sensitive", wantValue: "true"},
+		{tag: "log", wantErr: true},
+		{tag: "log:secret", wantErr: true},
+		{tag: `:"secret"`, wantErr: true},
+		{tag: `log:""`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			key, value, err := parseSensitiveTag(tt.tag)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSensitiveTag(%q) error = %v, wantErr %v", tt.tag, err, tt.wantErr)
+			}
+			if key != tt.wantKey || value != tt.wantValue {
+				t.Errorf("parseSensitiveTag(%q) = %q, %q, want %q, %q", tt.tag, key, value, tt.wantKey, tt.wantValue)
+			}
+		})
+	}
+}
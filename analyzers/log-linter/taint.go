@@ -0,0 +1,471 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ssa"
+)
+
+// secretEnvArgs — имена переменных окружения, значения которых считаются
+// секретными.
+var secretEnvArgs = []string{
+	"*SECRET*", "*TOKEN*", "*PASSWORD*", "*PASSWD*", "*API_KEY*", "*APIKEY*", "*CREDENTIAL*", "*PRIVATE*",
+}
+
+// defaultTaintSources — встроенные функции-источники режима Taint.
+var defaultTaintSources = []TaintSource{
+	{
+		Package:   "os",
+		Functions: []string{"Getenv", "LookupEnv"},
+		Args:      secretEnvArgs,
+	},
+}
+
+// taintStep — шаг распространения секретного значения.
+type taintStep struct {
+	// from — значение, от которого получено текущее; nil у источника.
+	from ssa.Value
+	// pos — позиция шага в исходном коде; может отсутствовать.
+	pos token.Pos
+	// what — описание шага: для источника — что именно считается
+	// секретным, для остальных — как значение было получено. Шаги без
+	// описания не попадают в путь.
+	what string
+	// named — источник найден по имени (поля, переменной, ключа);
+	// прямое использование таких источников проверяют правила по именам.
+	named bool
+}
+
+// tainter распространяет секретные значения по SSA функций пакета.
+type tainter struct {
+	pass    *analysis.Pass
+	cfg     Config
+	sources []TaintSource
+	steps   map[ssa.Value]taintStep
+}
+
+// checkTaint отслеживает секретные значения внутри функций пакета и
+// сообщает о вызовах логгеров (sinks), аргументы которых от них зависят.
+// Путь значения от источника до логгера попадает в Diagnostic.Related.
+//
+// Анализ внутрипроцедурный: значение не отслеживается через параметры
+// и результаты вызываемых функций, а считается производным от аргументов
+// только у функций, возвращающих строки, ошибки и поля логгеров, поэтому
+// обходятся лишь функции из файлов, где есть sinks.
+func checkTaint(pass *analysis.Pass, sinks map[token.Pos]*ast.CallExpr, prog *ssaProgram, cfg Config) {
+	t := &tainter{
+		pass:    pass,
+		cfg:     cfg,
+		sources: append(slices.Clone(defaultTaintSources), cfg.TaintSources...),
+		steps:   make(map[ssa.Value]taintStep),
+	}
+	files := make(map[*token.File]bool)
+	for pos := range sinks {
+		files[pass.Fset.File(pos)] = true
+	}
+	for _, fn := range prog.funcs(pass) {
+		if !files[pass.Fset.File(fn.Pos())] {
+			continue
+		}
+		t.propagate(fn)
+		t.checkSinks(fn, sinks)
+	}
+}
+
+// ssaProgram — SSA пакета, построенное не больше одного раза на проход:
+// его разделяют все области конфигурации (см. scopes) и анализаторы
+// правил, получившие один и тот же результат анализатора вызовов.
+type ssaProgram struct {
+	once  sync.Once
+	built []*ssa.Function
+}
+
+// funcs возвращает функции пакета (см. buildSSA), строя SSA при первом
+// обращении.
+func (p *ssaProgram) funcs(pass *analysis.Pass) []*ssa.Function {
+	p.once.Do(func() { p.built = buildSSA(pass) })
+	return p.built
+}
+
+// buildSSA строит SSA пакета и возвращает его функции, включая литералы,
+// в порядке следования в исходном коде.
+//
+// Повторяет buildssa.Analyzer, но не через Requires: анализатор
+// экспортирует факты и потому выполняется и на всех зависимостях, а SSA
+// нужно только пакетам с вызовами логгеров.
+func buildSSA(pass *analysis.Pass) []*ssa.Function {
+	prog := ssa.NewProgram(pass.Fset, 0)
+	for _, p := range pass.Pkg.Imports() {
+		prog.CreatePackage(p, nil, nil, true)
+	}
+	pkg := prog.CreatePackage(pass.Pkg, pass.Files, pass.TypesInfo, false)
+	pkg.Build()
+
+	var funcs []*ssa.Function
+	var addAnons func(*ssa.Function)
+	addAnons = func(f *ssa.Function) {
+		funcs = append(funcs, f)
+		for _, anon := range f.AnonFuncs {
+			addAnons(anon)
+		}
+	}
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			decl, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			fn, ok := pass.TypesInfo.Defs[decl.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+			if f := prog.FuncValue(fn); f != nil {
+				addAnons(f)
+			}
+		}
+	}
+	return funcs
+}
+
+// propagate помечает секретные значения функции, пока пометки меняются.
+func (t *tainter) propagate(fn *ssa.Function) {
+	for _, p := range fn.Params {
+		if t.cfg.names.match(p.Name()) {
+			t.source(p, fmt.Sprintf("parameter %q", p.Name()), true)
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, b := range fn.Blocks {
+			for _, instr := range b.Instrs {
+				if t.visit(instr) {
+					changed = true
+				}
+			}
+		}
+	}
+}
+
+// visit распространяет пометку через инструкцию. Сообщает, появилась ли
+// новая пометка.
+func (t *tainter) visit(instr ssa.Instruction) bool {
+	changed := false
+	for _, op := range instr.Operands(nil) {
+		if g, ok := (*op).(*ssa.Global); ok && t.cfg.names.match(g.Name()) {
+			changed = t.source(g, fmt.Sprintf("variable %q", g.Name()), true) || changed
+		}
+	}
+
+	switch i := instr.(type) {
+	case *ssa.FieldAddr:
+		if f, tag, ok := structField(i.X.Type(), i.Field, true); ok && t.isSensitiveField(f, tag) {
+			return t.source(i, fmt.Sprintf("field %q", f.Name()), true) || changed
+		}
+		return t.derive(i, i.X, "") || changed
+	case *ssa.Field:
+		if f, tag, ok := structField(i.X.Type(), i.Field, false); ok && t.isSensitiveField(f, tag) {
+			return t.source(i, fmt.Sprintf("field %q", f.Name()), true) || changed
+		}
+		return t.derive(i, i.X, "") || changed
+	case *ssa.Lookup:
+		if key, ok := constString(i.Index); ok && isStringMap(i.X.Type()) && t.cfg.names.match(key) {
+			return t.source(i, fmt.Sprintf("map key %q", key), true) || changed
+		}
+		return t.derive(i, i.X, "") || changed
+	case *ssa.BinOp:
+		if i.Op != token.ADD {
+			return changed
+		}
+		return t.derive(i, i.X, "concatenated here") || t.derive(i, i.Y, "concatenated here") || changed
+	case *ssa.UnOp:
+		if i.Op != token.MUL {
+			return changed
+		}
+		return t.derive(i, i.X, "") || t.derive(i, addrRoot(i.X), "") || changed
+	case *ssa.Call:
+		if what, named, ok := t.sourceCall(i.Common()); ok {
+			return t.source(i, what, named) || changed
+		}
+		if !propagatesTaint(i) {
+			return changed
+		}
+		what := fmt.Sprintf("passed to %s here", calleeName(i.Common()))
+		for _, arg := range i.Common().Args {
+			if t.derive(i, arg, what) {
+				return true
+			}
+		}
+		return changed
+	case *ssa.Store:
+		if !t.tainted(i.Val) {
+			return changed
+		}
+		changed = t.deriveAt(i.Addr, i.Val, i.Pos(), "stored here") || changed
+		return t.deriveAt(addrRoot(i.Addr), i.Val, i.Pos(), "stored here") || changed
+	case *ssa.MapUpdate:
+		return t.deriveAt(i.Map, i.Value, i.Pos(), "stored here") || changed
+	case *ssa.Convert, *ssa.ChangeType, *ssa.MultiConvert, *ssa.MakeInterface, *ssa.ChangeInterface,
+		*ssa.TypeAssert, *ssa.Slice, *ssa.Extract, *ssa.Index, *ssa.IndexAddr, *ssa.Phi:
+		v := instr.(ssa.Value)
+		for _, op := range instr.Operands(nil) {
+			if *op != nil && t.derive(v, *op, "") {
+				return true
+			}
+		}
+	}
+	return changed
+}
+
+// checkSinks сообщает о вызовах логгеров функции, аргументы которых
+// помечены как секретные.
+func (t *tainter) checkSinks(fn *ssa.Function, sinks map[token.Pos]*ast.CallExpr) {
+	for _, b := range fn.Blocks {
+		for _, instr := range b.Instrs {
+			call, ok := instr.(ssa.CallInstruction)
+			if !ok {
+				continue
+			}
+			common := call.Common()
+			sink, ok := sinks[common.Pos()]
+			if !ok {
+				continue
+			}
+			for _, arg := range callArgs(common) {
+				if t.tainted(arg) && t.report(sink, arg) {
+					break
+				}
+			}
+		}
+	}
+}
+
+// report сообщает о секретном значении v, дошедшем до вызова логгера sink.
+// Не сообщает, если источник найден по имени и находится в самом вызове:
+// такие случаи проверяют правила по именам.
+func (t *tainter) report(sink *ast.CallExpr, v ssa.Value) bool {
+	var path []taintStep
+	for v != nil {
+		step := t.steps[v]
+		path = append(path, step)
+		v = step.from
+	}
+	src := path[len(path)-1]
+	if src.named && src.pos >= sink.Pos() && src.pos < sink.End() {
+		return false
+	}
+
+	var related []analysis.RelatedInformation
+	for _, step := range slices.Backward(path) {
+		if !step.pos.IsValid() || step.what == "" {
+			continue
+		}
+		msg := step.what
+		if step.from == nil {
+			msg = "sensitive data originates here"
+		}
+		if n := len(related); n > 0 && related[n-1].Pos == step.pos {
+			continue
+		}
+		related = append(related, analysis.RelatedInformation{Pos: step.pos, Message: msg})
+	}
+	t.pass.Report(analysis.Diagnostic{
+		Pos:      sink.Pos(),
+		Category: RuleSensitiveData,
+		Message:  fmt.Sprintf("potentially sensitive data from %s reaches logger", src.what),
+		Related:  related,
+	})
+	return true
+}
+
+// source помечает v как источник секретных данных.
+func (t *tainter) source(v ssa.Value, what string, named bool) bool {
+	if _, ok := t.steps[v]; ok {
+		return false
+	}
+	t.steps[v] = taintStep{pos: v.Pos(), what: what, named: named}
+	return true
+}
+
+// derive помечает v как производное от from, если from помечено.
+func (t *tainter) derive(v, from ssa.Value, what string) bool {
+	return t.deriveAt(v, from, v.Pos(), what)
+}
+
+// deriveAt помечает v как производное от from с явной позицией шага.
+func (t *tainter) deriveAt(v, from ssa.Value, pos token.Pos, what string) bool {
+	if v == nil || v == from || !t.tainted(from) {
+		return false
+	}
+	if _, ok := t.steps[v]; ok {
+		return false
+	}
+	t.steps[v] = taintStep{from: from, pos: pos, what: what}
+	return true
+}
+
+// tainted сообщает, помечено ли значение как секретное.
+func (t *tainter) tainted(v ssa.Value) bool {
+	_, ok := t.steps[v]
+	return ok
+}
+
+// isSensitiveField сообщает, является ли поле структуры секретным:
+// по имени или по тегу Config.SensitiveTag.
+func (t *tainter) isSensitiveField(f *types.Var, tag string) bool {
+	return t.cfg.names.match(f.Name()) || hasSensitiveTag(tag, t.cfg.SensitiveTag)
+}
+
+// sourceCall проверяет, является ли вызов источником секретных данных:
+// функцией из TaintSources или геттером из sensitiveGetters с
+// чувствительным ключом. Возвращает описание источника.
+func (t *tainter) sourceCall(common *ssa.CallCommon) (what string, named, ok bool) {
+	fn := calleeFunc(common)
+	if fn == nil {
+		return "", false, false
+	}
+	pkg, recv := funcReceiver(fn)
+	arg, hasArg := constString(firstArg(common))
+	what = fmt.Sprintf("%s()", calleeName(common))
+	if hasArg {
+		what = fmt.Sprintf("%s(%q)", calleeName(common), arg)
+	}
+
+	if recv != "" && sensitiveGetters[pkg+"."+recv+"."+fn.Name()] {
+		return what, true, hasArg && isSensitiveHeader(arg, t.cfg.names)
+	}
+	for _, src := range t.sources {
+		if src.Package != pkg || src.Receiver != recv || !slices.Contains(src.Functions, fn.Name()) {
+			continue
+		}
+		if len(src.Args) == 0 || hasArg && matchesAny(src.Args, arg) {
+			return what, false, true
+		}
+	}
+	return "", false, false
+}
+
+// matchesAny сообщает, совпадает ли s хотя бы с одним glob-шаблоном
+// без учёта регистра.
+func matchesAny(patterns []string, s string) bool {
+	s = strings.ToLower(s)
+	for _, p := range patterns {
+		if ok, _ := path.Match(strings.ToLower(p), s); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// propagatesTaint сообщает, считается ли результат вызова производным
+// от его аргументов: у функций, возвращающих строки, байты или ошибки,
+// у конструкторов полей логгеров и у встроенной append.
+func propagatesTaint(call *ssa.Call) bool {
+	if b, ok := call.Common().Value.(*ssa.Builtin); ok {
+		return b.Name() == "append"
+	}
+	if fn := calleeFunc(call.Common()); fn != nil && fn.Pkg() != nil && fieldPackages[fn.Pkg().Path()] {
+		return true
+	}
+	switch typ := call.Type(); {
+	case isStringType(typ), types.Identical(typ, types.Universe.Lookup("error").Type()):
+		return true
+	default:
+		s, ok := typ.Underlying().(*types.Slice)
+		return ok && types.Identical(s.Elem(), types.Typ[types.Byte])
+	}
+}
+
+// calleeFunc возвращает вызываемую функцию или метод, если он известен
+// статически либо вызывается через интерфейс.
+func calleeFunc(common *ssa.CallCommon) *types.Func {
+	if common.IsInvoke() {
+		return common.Method
+	}
+	if callee := common.StaticCallee(); callee != nil {
+		fn, _ := callee.Object().(*types.Func)
+		return fn
+	}
+	return nil
+}
+
+// calleeName возвращает короткое имя вызываемой функции: os.Getenv,
+// Header.Get.
+func calleeName(common *ssa.CallCommon) string {
+	fn := calleeFunc(common)
+	if fn == nil {
+		return common.Value.Name()
+	}
+	if _, recv := funcReceiver(fn); recv != "" {
+		return recv + "." + fn.Name()
+	}
+	if fn.Pkg() != nil {
+		return fn.Pkg().Name() + "." + fn.Name()
+	}
+	return fn.Name()
+}
+
+// callArgs возвращает аргументы вызова без получателя метода.
+func callArgs(common *ssa.CallCommon) []ssa.Value {
+	if !common.IsInvoke() && common.Signature().Recv() != nil && len(common.Args) > 0 {
+		return common.Args[1:]
+	}
+	return common.Args
+}
+
+// firstArg возвращает первый аргумент вызова без учёта получателя.
+func firstArg(common *ssa.CallCommon) ssa.Value {
+	if args := callArgs(common); len(args) > 0 {
+		return args[0]
+	}
+	return nil
+}
+
+// constString возвращает значение строковой константы.
+func constString(v ssa.Value) (string, bool) {
+	c, ok := v.(*ssa.Const)
+	if !ok || c.Value == nil || c.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(c.Value), true
+}
+
+// addrRoot возвращает значение, в котором лежит адрес: для &x.f и &a[i] —
+// адрес x или a.
+func addrRoot(addr ssa.Value) ssa.Value {
+	for {
+		switch a := addr.(type) {
+		case *ssa.FieldAddr:
+			addr = a.X
+		case *ssa.IndexAddr:
+			addr = a.X
+		default:
+			return addr
+		}
+	}
+}
+
+// structField возвращает поле структуры с индексом idx и его тег. Если
+// ptr установлен, typ — указатель на структуру.
+func structField(typ types.Type, idx int, ptr bool) (*types.Var, string, bool) {
+	if ptr {
+		p, ok := typ.Underlying().(*types.Pointer)
+		if !ok {
+			return nil, "", false
+		}
+		typ = p.Elem()
+	}
+	s, ok := typ.Underlying().(*types.Struct)
+	if !ok || idx >= s.NumFields() {
+		return nil, "", false
+	}
+	return s.Field(idx), s.Tag(idx), true
+}
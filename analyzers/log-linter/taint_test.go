@@ -0,0 +1,27 @@
+package analyzer
+
+import "testing"
+
+// ---------- TestMatchesAny ----------
+
+func TestMatchesAny(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{s: "DB_SECRET", want: true},
+		{s: "db_secret", want: true},
+		{s: "GITHUB_TOKEN", want: true},
+		{s: "STRIPE_API_KEY", want: true},
+		{s: "HOST"},
+		{s: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := matchesAny(secretEnvArgs, tt.s); got != tt.want {
+				t.Errorf("matchesAny(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}
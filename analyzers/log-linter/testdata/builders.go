@@ -0,0 +1,41 @@
+package testdata
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+type member struct {
+	Name     string
+	Password string
+}
+
+func someBuilders(logger *zap.Logger, token string, acc member, names []string, tokens []string) {
+	slog.Info(fmt.Sprintf("token=%s", token))                                            // want `potentially sensitive data "token" is concatenated into log message`
+	slog.Info(fmt.Sprint("user ", acc.Name, " ", acc.Password))                          // want `potentially sensitive data "acc.Password" is concatenated into log message`
+	slog.Info(strings.Join([]string{"t", token}, ""))                                    // want `potentially sensitive data "token" is concatenated into log message`
+	slog.Info(strings.Join(tokens, ","))                                                 // want `potentially sensitive data "tokens" is concatenated into log message`
+	slog.Info(errors.New("bad " + token).Error())                                        // want `potentially sensitive data "token" is concatenated into log message`
+	logger.Info(fmt.Errorf("login %s: %w", acc.Password, errors.ErrUnsupported).Error()) // want `potentially sensitive data "acc.Password" is concatenated into log message`
+	slog.Info("request " + fmt.Sprintf("%s", token))                                     // want `potentially sensitive data "token" is concatenated into log message`
+
+	var b strings.Builder
+	b.WriteString("token ")
+	b.WriteString(token)
+	slog.Info(b.String()) // want `potentially sensitive data "token" is concatenated into log message`
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "auth %s", acc.Password)
+	slog.Info(buf.String()) // want `potentially sensitive data "acc.Password" is concatenated into log message`
+
+	var safe strings.Builder
+	safe.WriteString(acc.Name)
+	slog.Info(safe.String())
+	slog.Info(fmt.Sprintf("user %s", acc.Name))
+	slog.Info(strings.Join(names, ","))
+}
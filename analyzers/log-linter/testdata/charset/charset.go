@@ -0,0 +1,15 @@
+package charset
+
+import "log/slog"
+
+func someCharset() {
+	slog.Info("listening on :8080")
+	slog.Info("retrying (attempt 3)")
+	slog.Info("user-id invalid")
+	slog.Info("file.txt missing")
+	slog.Info("пользователь создан")
+
+	slog.Info("done!")           // want `log messages must not contains any special symbols`
+	slog.Info("привет σ мир")    // want `log messages must only contains latin letters`
+	slog.Info("server stopped.") // want `log message does not match pattern "\[\^\.\]\$"`
+}
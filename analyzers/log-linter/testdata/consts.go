@@ -0,0 +1,33 @@
+package testdata
+
+import (
+	"log/slog"
+	"os"
+)
+
+const (
+	msgStart   = "Starting"
+	msgStopped = "stopped"
+	msgPrefix  = "server "
+	msgBad     = "привeт"
+)
+
+func someConsts() {
+	// Именованные константы
+	slog.Info(msgStart) // want "log messages must start with lowercase letter"
+	slog.Info(msgStopped)
+	slog.Warn(msgBad) // want "log messages must only contains latin letters"
+
+	// Конкатенация литералов и констант
+	slog.Info("Hello " + "world") // want "log messages must start with lowercase letter"
+	slog.Info(msgPrefix + "ready")
+	slog.Info(msgPrefix + "ready!") // want "log messages must not contains any special symbols"
+	slog.Info(("hello" + "!"))      // want "log messages must not contains any special symbols"
+
+	// Константы из других пакетов проверяются, но не исправляются
+	slog.Info(os.DevNull) // want "log messages must not contains any special symbols"
+
+	// Неконстантные сообщения не проверяются
+	msg := "Hello"
+	slog.Info(msg)
+}
@@ -0,0 +1,16 @@
+package fixes
+
+import "log/slog"
+
+const (
+	msgStart  = "Starting"
+	msgPrefix = "server: "
+	msgDone   = msgPrefix + "Done!"
+)
+
+func someConsts() {
+	slog.Info(msgStart)             // want "log messages must start with lowercase letter"
+	slog.Info(msgPrefix + "ready")  // want "log messages must not contains any special symbols"
+	slog.Info("Hello " + "world")   // want "log messages must start with lowercase letter"
+	slog.Info("hello, " + "world!") // want "log messages must not contains any special symbols"
+}
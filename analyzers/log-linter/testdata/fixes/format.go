@@ -0,0 +1,14 @@
+package fixes
+
+import (
+	"log"
+
+	"github.com/sirupsen/logrus"
+)
+
+func someFormat(name string, n int) {
+	log.Printf("user %s not found!", name)     // want "log messages must not contains any special symbols"
+	logrus.Infof("retry %d: %-5s...", n, name) // want "log messages must not contains any special symbols"
+	log.Printf("User %s", name)                // want "log messages must start with lowercase letter"
+	log.Print("hello, world")                  // want "log messages must not contains any special symbols"
+}
@@ -2,6 +2,15 @@ module testdata
 
 go 1.25.7
 
-require go.uber.org/zap v1.27.1
+require (
+	github.com/rs/zerolog v1.34.0
+	github.com/sirupsen/logrus v1.9.3
+	go.uber.org/zap v1.27.1
+)
 
-require go.uber.org/multierr v1.11.0 // indirect
+require (
+	github.com/mattn/go-colorable v0.1.13 // indirect
+	github.com/mattn/go-isatty v0.0.19 // indirect
+	go.uber.org/multierr v1.11.0 // indirect
+	golang.org/x/sys v0.12.0 // indirect
+)
@@ -0,0 +1,10 @@
+//loglinter:file-ignore latin-only -- messages of this package are localized
+
+package file
+
+import "log/slog"
+
+func someFileIgnores() {
+	slog.Info("запуск сервиса")
+	slog.Info("остановка сервиса")
+}
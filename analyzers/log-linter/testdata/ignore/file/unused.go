@@ -0,0 +1,3 @@
+//loglinter:file-ignore hardcoded-secret -- fixtures // want `unused loglinter:file-ignore directive for rule "hardcoded-secret"`
+
+package file
@@ -0,0 +1,28 @@
+package ignore
+
+import "log/slog"
+
+func someIgnores(password string) {
+	slog.Info("Starting") //loglinter:ignore lowercase -- name of the service
+
+	//loglinter:ignore sensitive-data -- value is masked by the handler
+	slog.Info("login", "pass", password)
+
+	//loglinter:ignore lowercase,special-symbols -- banner is printed as is
+	slog.Info("Ready!")
+
+	slog.Info("Stopped") //loglinter:ignore special-symbols -- reason // want `unused loglinter:ignore directive for rule "special-symbols"` `log messages must start with lowercase letter`
+
+	slog.Info("Paused") //loglinter:ignore lowercase // want `loglinter:ignore directive must have a reason after --`
+
+	slog.Info("resumed") //loglinter:ignore no-such-rule -- typo // want `unknown rule "no-such-rule" in loglinter:ignore directive`
+
+	slog.Info("Done") //loglinter:ignore -- nothing to ignore // want `loglinter:ignore directive must name at least one rule` `log messages must start with lowercase letter`
+
+	slog.Info("Opened") //loglinter:ignore lowercase -- name of the resource
+	slog.Info("Closed") // want `log messages must start with lowercase letter`
+
+	//loglinter:ignore lowercase -- too far away // want `unused loglinter:ignore directive for rule "lowercase"`
+
+	slog.Info("Finished") // want `log messages must start with lowercase letter`
+}
@@ -0,0 +1,8 @@
+package unused
+
+import "log/slog"
+
+func someUnused() {
+	slog.Info("Started") //loglinter:ignore lowercase -- name of the service
+	slog.Info("ready")   //loglinter:ignore lowercase -- nothing to ignore // want `unused loglinter:ignore directive for rule "lowercase"`
+}
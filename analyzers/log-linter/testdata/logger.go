@@ -13,6 +13,7 @@ func some() {
 	slog.Debug("hello")
 	log.Fatal("Hello") // want "log messages must start with lowercase letter"
 	log.Info("hello")
+	log.Log(zap.WarnLevel, "Hello") // want "log messages must start with lowercase letter"
 
 	// Логи содержат исключительно латинские буквы
 	slog.Warn("привeт") // want "log messages must only contains latin letters"
@@ -0,0 +1,46 @@
+package testdata
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+)
+
+func someLogrus() {
+	logger := logrus.New()
+	entry := logrus.WithField("user", "alice")
+	var fieldLogger logrus.FieldLogger = logger
+	err := errors.New("boom")
+
+	// Функции пакета
+	logrus.Info("Hello")   // want "log messages must start with lowercase letter"
+	logrus.Infof("Hello")  // want "log messages must start with lowercase letter"
+	logrus.Infoln("Hello") // want "log messages must start with lowercase letter"
+	logrus.Debug("hello")
+
+	// Методы Logger
+	logger.Warn("привeт")     // want "log messages must only contains latin letters"
+	logger.Warningf("hello!") // want "log messages must not contains any special symbols"
+	logger.Println("hello")
+	logger.Log(logrus.InfoLevel, "Hello")   // want "log messages must start with lowercase letter"
+	logger.Logf(logrus.InfoLevel, "hello!") // want "log messages must not contains any special symbols"
+
+	// Цепочки Entry
+	logger.WithField("user", "alice").Warn("Hello") // want "log messages must start with lowercase letter"
+	entry.WithError(err).Error("hello!")            // want "log messages must not contains any special symbols"
+	entry.WithError(err).Errorln("hello")
+	entry.Tracef("Hello") // want "log messages must start with lowercase letter"
+
+	// Интерфейс FieldLogger
+	fieldLogger.Info("Hello") // want "log messages must start with lowercase letter"
+	fieldLogger.WithField("k", 1).Info("hello")
+
+	// Потенциально чувствительные данные
+	token := "abracadabra"
+	logrus.Info("hello" + token)                           // want "potentially sensitive data \"token\" is concatenated into log message"
+	logger.WithField("user", "alice").Debugf("hi" + token) // want "potentially sensitive data \"token\" is concatenated into log message"
+
+	// Не методы логирования
+	logrus.WithField("User", "Alice")
+	logger.SetLevel(logrus.DebugLevel)
+}
@@ -0,0 +1,15 @@
+package testdata
+
+import "log/slog"
+
+func someNames() {
+	author := "alice"
+	oauthProvider := "github"
+	authToken := "abc"
+
+	// Паттерны совпадают со словами имени, а не с подстроками
+	slog.Info("book by " + author)
+	slog.Info("login", "provider", oauthProvider)
+	slog.Info("login", "authors", author)
+	slog.Info("login " + authToken) // want "potentially sensitive data \"authToken\" is concatenated into log message"
+}
@@ -0,0 +1,10 @@
+package app
+
+import "log/slog"
+
+func run(sessionID, email string) {
+	slog.Info("Started") // want `log messages must start with lowercase letter`
+	slog.Info("запуск")  // want `log messages must only contains latin letters`
+	slog.Info("login", "session", sessionID)
+	slog.Info("login", "email", email)
+}
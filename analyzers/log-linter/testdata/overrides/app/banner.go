@@ -0,0 +1,8 @@
+package app
+
+import "log/slog"
+
+func banner() {
+	slog.Info("запуск сервиса")
+	slog.Info("запуск: сервис") // want `log messages must not contains any special symbols`
+}
@@ -0,0 +1,9 @@
+package main
+
+import "log/slog"
+
+func main() {
+	slog.Info("Listening on :8080")
+	slog.Info("done!")
+	slog.Info("готово") // want `log messages must only contains latin letters`
+}
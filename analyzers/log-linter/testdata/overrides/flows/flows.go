@@ -0,0 +1,17 @@
+package flows
+
+import "log/slog"
+
+type config struct {
+	Token   string
+	Session string
+}
+
+func tokenFlow(cfg *config) {
+	t := cfg.Token
+	msg := "got " + t
+	slog.Info(msg) // want `potentially sensitive data from field "Token" reaches logger`
+
+	s := cfg.Session
+	slog.Info("id " + s)
+}
@@ -0,0 +1,9 @@
+package flows
+
+import "log/slog"
+
+func sessionFlow(cfg *config) {
+	s := cfg.Session
+	msg := "id " + s
+	slog.Info(msg) // want `potentially sensitive data from field "Session" reaches logger`
+}
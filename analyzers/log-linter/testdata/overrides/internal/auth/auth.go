@@ -0,0 +1,10 @@
+package auth
+
+import "log/slog"
+
+func login(sessionID, email, user string) {
+	slog.Info("login", "session", sessionID) // want `\[sensitive-data\] error: potentially sensitive key "session" is passed to logger`
+	slog.Info("login", "email", email)       // want `\[pii\] potentially personal data key "email" is passed to logger`
+	slog.Info("login", "user", user)
+	slog.Info("Login") // want `log messages must start with lowercase letter`
+}
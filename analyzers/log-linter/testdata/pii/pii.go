@@ -0,0 +1,34 @@
+package pii
+
+import (
+	"log/slog"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+type customer struct {
+	ID    int
+	Email string
+	Phone string
+}
+
+func somePII(c customer, logger *zap.Logger, ssn string, params map[string]string) {
+	slog.Info("customer " + c.Email)                                        // want `potentially personal data "c.Email" is concatenated into log message`
+	slog.Info("customer", "email", c.ID)                                    // want `potentially personal data key "email" is passed to logger`
+	slog.Info("customer", "id", ssn)                                        // want `potentially personal data "ssn" is passed to logger`
+	logger.Info("customer", zap.String("contact", c.Phone))                 // want `potentially personal data "c.Phone" is passed to logger`
+	logger.Info("customer", zap.String("id", params["passport"]))           // want `potentially personal data "params\[\\"passport\\"\]" is passed to logger`
+	logrus.WithFields(logrus.Fields{"mobile_phone": c.ID}).Info("customer") // want `potentially personal data key "mobile_phone" is passed to logger`
+	slog.Info("customer", "contact", "john.doe@example.com")                // want "field value contains personal data: email address"
+	slog.Info("customer", "contact", "+1 (555) 010-9999")                   // want "field value contains personal data: phone number"
+	slog.Info("payment", "account", "DE89 3704 0044 0532 0130 00")          // want "field value contains personal data: IBAN"
+	slog.Info("payment", "pan", "4111 1111 1111 1111")                      // want "field value contains personal data: card number"
+	slog.Info("charged card 4111111111111111")                              // want "log message contains personal data: card number"
+
+	slog.Info("customer", "id", c.ID)
+	slog.Info("payment", "order", "4111 1111 1111 1112")
+	slog.Info("payment", "account", "DE00 3704 0044 0532 0130 00")
+	slog.Info("request", "started_at", "2024-01-02 10:11:12")
+	slog.Info("request", "latency_ms", 1700000000000)
+}
@@ -0,0 +1,53 @@
+package testdata
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+type service struct {
+	logger *zap.Logger
+}
+
+type deps struct {
+	Log *slog.Logger
+}
+
+type handler struct {
+	deps deps
+}
+
+// embedded встраивает логгер, его методы продвигаются.
+type embedded struct {
+	*zap.Logger
+}
+
+// ownLogger объявляет собственный метод Info, он не является логгером.
+type ownLogger struct {
+	*zap.Logger
+}
+
+func (ownLogger) Info(string) {}
+
+func someReceivers(s *service, h handler, e embedded, o ownLogger, loggers []*slog.Logger, byName map[string]*zap.Logger) {
+	// Поля структур
+	s.logger.Info("Hello")    // want "log messages must start with lowercase letter"
+	h.deps.Log.Warn("hello!") // want "log messages must not contains any special symbols"
+
+	// Результаты вызовов
+	zap.L().Info("Hello")          // want "log messages must start with lowercase letter"
+	slog.Default().Error("привeт") // want "log messages must only contains latin letters"
+	zap.S().Infow("Hello")         // want "log messages must start with lowercase letter"
+
+	// Индексные выражения
+	loggers[0].Info("Hello")       // want "log messages must start with lowercase letter"
+	byName["main"].Debug("hello!") // want "log messages must not contains any special symbols"
+
+	// Встроенные поля
+	e.Info("Hello")        // want "log messages must start with lowercase letter"
+	e.Logger.Warn("Hello") // want "log messages must start with lowercase letter"
+
+	// Собственный метод, перекрывающий метод логгера
+	o.Info("Hello")
+}
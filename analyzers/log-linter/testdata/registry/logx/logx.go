@@ -0,0 +1,12 @@
+// Package logx — пример внутреннего фасада логирования.
+package logx
+
+import "context"
+
+type Logger struct{}
+
+func (Logger) Event(ctx context.Context, msg string, kv ...any) {}
+
+func (Logger) Setup(name string) {}
+
+func Notice(msg string, kv ...any) {}
@@ -0,0 +1,11 @@
+package receiver
+
+import "go.uber.org/zap"
+
+func someReceiver(l *zap.Logger) {
+	// Метод, добавленный к zap.Logger конфигурацией
+	l.Named("Worker") // want "log messages must start with lowercase letter"
+
+	// Встроенные методы того же типа остаются в силе
+	l.Info("Hello") // want "log messages must start with lowercase letter"
+}
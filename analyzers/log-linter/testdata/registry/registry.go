@@ -0,0 +1,22 @@
+package registry
+
+import (
+	"context"
+	"log/slog"
+
+	"testdata/registry/logx"
+)
+
+func someRegistry(ctx context.Context, l logx.Logger) {
+	token := "abracadabra"
+
+	// Логгеры из конфигурации
+	logx.Notice("Hello")                  // want "log messages must start with lowercase letter"
+	logx.Notice("hello", "secret", token) // want "potentially sensitive key \"secret\" is passed to logger"
+	l.Event(ctx, "hello!")                // want "log messages must not contains any special symbols"
+	l.Event(ctx, "hello", "password", 1)  // want "potentially sensitive key \"password\" is passed to logger"
+	l.Setup("Hello")
+
+	// Встроенные логгеры остаются в силе
+	slog.Info("Hello") // want "log messages must start with lowercase letter"
+}
@@ -0,0 +1,10 @@
+package rules
+
+import "log/slog"
+
+func someRules(password string) {
+	slog.Info("Привет")                  // want `^\[lowercase\] log messages must start with lowercase letter$`
+	slog.Info("done!")                   // want `^\[special-symbols\] warning: log messages must not contains any special symbols$`
+	slog.Info("login", "pass", password) // want `^\[sensitive-data\] error: potentially sensitive data "password" is passed to logger$`
+	slog.Info("contact", "email", "")    // want `^\[pii\] potentially personal data key "email" is passed to logger$`
+}
@@ -0,0 +1,9 @@
+package symbols
+
+import "log/slog"
+
+func someSymbols(password string) {
+	slog.Info("Done!") // want `^\[special-symbols\] log messages must not contains any special symbols$`
+	slog.Info("привет")
+	slog.Info("login", "pass", password)
+}
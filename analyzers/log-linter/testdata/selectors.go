@@ -0,0 +1,40 @@
+package testdata
+
+import (
+	"log/slog"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+type dbConfig struct {
+	Host   string
+	Secret string
+}
+
+type appConfig struct {
+	DB dbConfig
+}
+
+type account struct {
+	Name     string
+	Password string
+}
+
+func someSelectors(r *http.Request, u account, cfg appConfig, params map[string]string) {
+	// Поля структур
+	slog.Info("user: " + u.Password)  // want "potentially sensitive data \"u.Password\" is concatenated into log message"
+	slog.Info("db: " + cfg.DB.Secret) // want "potentially sensitive data \"cfg.DB.Secret\" is concatenated into log message"
+	slog.Info("db: " + cfg.DB.Host)
+	slog.Info("user: " + u.Name)
+
+	// Элементы отображений
+	slog.Info("param: " + params["api_token"]) // want `potentially sensitive data "params\[\\"api_token\\"\]" is concatenated into log message`
+	slog.Info("param: " + params["page"])
+
+	// Заголовки и параметры запроса
+	slog.Info("auth: " + r.Header.Get("Authorization")) // want `potentially sensitive data "r.Header.Get\(\\"Authorization\\"\)" is concatenated into log message`
+	slog.Info("type: " + r.Header.Get("Content-Type"))
+	slog.Info("login", "user", u.Name, "pw", u.Password)              // want "potentially sensitive data \"u.Password\" is passed to logger"
+	zap.L().Info("login", zap.String("key", r.FormValue("password"))) // want `potentially sensitive data "r.FormValue\(\\"password\\"\)" is passed to logger`
+}
@@ -0,0 +1,38 @@
+package testdata
+
+import (
+	"context"
+	"log/slog"
+)
+
+const keyToken = "api_token"
+
+func someSlogAttrs(ctx context.Context, logger *slog.Logger) {
+	user := "alice"
+	pw := "123123"
+	password := "123123"
+	authHeader := "Bearer abc"
+
+	// Пары ключ-значение
+	slog.Info("login", "password", pw)               // want "potentially sensitive key \"password\" is passed to logger"
+	slog.Info("login", "user", user, "pw", password) // want "potentially sensitive data \"password\" is passed to logger"
+	slog.Info("login", "user", user, keyToken, pw)   // want "potentially sensitive key \"api_token\" is passed to logger"
+	slog.Info("login", "token", password)            // want "potentially sensitive key \"token\" is passed to logger"
+	logger.InfoContext(ctx, "login", "user", user)
+
+	// Конструкторы slog.Attr
+	slog.Info("login", slog.String("api_token", pw))                           // want "potentially sensitive key \"api_token\" is passed to logger"
+	slog.Info("login", slog.String("header", authHeader))                      // want "potentially sensitive data \"authHeader\" is passed to logger"
+	slog.Info("login", slog.Int("attempt", 1), "secret", pw)                   // want "potentially sensitive key \"secret\" is passed to logger"
+	logger.LogAttrs(ctx, slog.LevelInfo, "login", slog.Any("credentials", pw)) // want "potentially sensitive key \"credentials\" is passed to logger"
+	slog.Info("login", slog.String("user", user))
+
+	// Группы
+	slog.Info("login", slog.Group("req", "password", pw))                // want "potentially sensitive key \"password\" is passed to logger"
+	slog.Info("login", slog.Group("req", slog.String("user", password))) // want "potentially sensitive data \"password\" is passed to logger"
+	slog.Info("login", slog.Group("auth", "user", pw))                   // want "potentially sensitive key \"auth\" is passed to logger"
+
+	// Литералы slog.Attr
+	slog.Info("login", slog.Attr{Key: "secret", Value: slog.StringValue(pw)})   // want "potentially sensitive key \"secret\" is passed to logger"
+	slog.Info("login", slog.Attr{Key: "pw", Value: slog.StringValue(password)}) // want "potentially sensitive data \"password\" is passed to logger"
+}
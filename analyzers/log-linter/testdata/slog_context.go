@@ -0,0 +1,28 @@
+package testdata
+
+import (
+	"context"
+	"log/slog"
+)
+
+func someSlogContext(ctx context.Context, logger *slog.Logger) {
+	token := "abracadabra"
+
+	// Варианты *Context: сообщение — второй аргумент
+	slog.InfoContext(ctx, "Hello") // want "log messages must start with lowercase letter"
+	slog.DebugContext(ctx, "hello", "k", 1)
+	logger.ErrorContext(ctx, "hello!")     // want "log messages must not contains any special symbols"
+	logger.WarnContext(ctx, "привeт")      // want "log messages must only contains latin letters"
+	logger.InfoContext(ctx, "hello"+token) // want "potentially sensitive data \"token\" is concatenated into log message"
+
+	// Log и LogAttrs с явным уровнем: сообщение — третий аргумент
+	slog.Log(ctx, slog.LevelWarn, "Hello") // want "log messages must start with lowercase letter"
+	logger.Log(ctx, slog.LevelInfo, "hello", "k", 1)
+	logger.LogAttrs(ctx, slog.LevelError, "hello!", slog.Int("attempt", 3)) // want "log messages must not contains any special symbols"
+	slog.LogAttrs(ctx, slog.LevelDebug, "hello")
+	slog.InfoContext(ctx, "hello", "password", token) // want "potentially sensitive key \"password\" is passed to logger"
+
+	// Не методы логирования
+	logger.With("Key", "Value")
+	logger.Enabled(ctx, slog.LevelInfo)
+}
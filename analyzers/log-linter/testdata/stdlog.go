@@ -0,0 +1,51 @@
+package testdata
+
+import (
+	"log"
+	"os"
+)
+
+func someStdLog() {
+	logger := log.New(os.Stderr, "", 0)
+	name := "alice"
+	token := "abracadabra"
+
+	// Функции пакета
+	log.Print("Hello")                    // want "log messages must start with lowercase letter"
+	log.Println("hello!")                 // want "log messages must not contains any special symbols"
+	log.Printf("user %s not found", name) // глаголы формата не являются спецсимволами
+	log.Printf("User %s not found", name) // want "log messages must start with lowercase letter"
+	log.Printf("привeт %d", 1)            // want "log messages must only contains latin letters"
+	log.Printf("done %d%%", 100)          // want "log messages must not contains any special symbols"
+	log.Print("hello" + token)            // want "potentially sensitive data \"token\" is concatenated into log message"
+
+	// Методы log.Logger
+	logger.Printf("attempt %d of %d", 1, 3)
+	logger.Println("Hello") // want "log messages must start with lowercase letter"
+	logger.Panicf("hello!") // want "log messages must not contains any special symbols"
+
+	// Не методы логирования
+	logger.SetPrefix("Prefix: ")
+	log.SetFlags(0)
+}
+
+func someFormatArgs() {
+	name := "alice"
+
+	// Несоответствие глаголов и аргументов
+	log.Printf("user %s not found")          // want `log.Printf format %s reads arg #1, but call has 0 args`
+	log.Printf("user %s not found", name, 1) // want `log.Printf call needs 1 arg but has 2 args`
+	log.Printf("user %[2]s not found %[1]d", 1, name)
+	log.Printf("user %[1]s not found", name, 1)
+	log.Printf("user %[2]*[1]d", 1, 5)
+	log.Printf("user %[3]*.[2]*[1]f", 1.5, 2, 8)
+	log.Printf("user %[3]s not found", name) // want `log.Printf format %\[3\]s reads arg #3, but call has 1 arg`
+	log.Printf("user %*d", 5, 1)
+	log.Printf("user %*d", 5, 1, 2)      // want `log.Printf call needs 2 args but has 3 args`
+	log.Printf("user %*d", 5)            // want `log.Printf format %\*d reads arg #2, but call has 1 arg`
+	log.Printf("done 100%% %s", name)    // want "log messages must not contains any special symbols"
+	log.Print("user %s not found", name) // want "log messages must not contains any special symbols"
+
+	args := []any{name}
+	log.Printf("user %s %s", args...)
+}
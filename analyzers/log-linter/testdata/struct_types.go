@@ -0,0 +1,86 @@
+package testdata
+
+import (
+	"log"
+	"log/slog"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+type credentials struct {
+	Login        string
+	PasswordHash string
+}
+
+type profile struct {
+	ID    int
+	Creds credentials
+}
+
+type session struct {
+	UserID int
+	Cookie string `json:"cookie" log:"secret"`
+}
+
+type plainUser struct {
+	ID      int
+	Name    string
+	Created time.Time
+}
+
+// Типы, сами определяющие своё представление в логе.
+type valuerUser struct{ Password string }
+
+func (valuerUser) LogValue() slog.Value { return slog.StringValue("user") }
+
+type marshalerUser struct{ Password string }
+
+func (marshalerUser) MarshalLogObject(zapcore.ObjectEncoder) error { return nil }
+
+type stringerUser struct{ Password string }
+
+func (*stringerUser) String() string { return "user" }
+
+// Метод с указателем-получателем не вызывается у значения, а метод
+// с другой сигнатурой не делает тип fmt.Stringer.
+type ptrStringerUser struct{ Password string }
+
+func (*ptrStringerUser) String() string { return "user" }
+
+type badStringerUser struct{ Password string }
+
+func (badStringerUser) String(bool) string { return "user" }
+
+type badValuerUser struct{ Password string }
+
+func (badValuerUser) LogValue() string { return "user" }
+
+func someStructTypes(z *zap.Logger, zl zerolog.Logger) {
+	c := credentials{}
+	p := &profile{}
+	s := session{}
+	users := []plainUser{}
+
+	slog.Info("login", "user", c)                               // want `value of type credentials contains potentially sensitive field "PasswordHash"`
+	slog.Info("login", slog.Any("profile", p))                  // want `value of type \*profile contains potentially sensitive field "Creds.PasswordHash"`
+	slog.Info("login", "session", s)                            // want `value of type session contains potentially sensitive field "Cookie"`
+	z.Info("login", zap.Any("r", []profile{}))                  // want `value of type \[\]profile contains potentially sensitive field "Creds.PasswordHash"`
+	z.Info("login", zap.Reflect("sessions", map[int]session{})) // want `value of type map\[int\]session contains potentially sensitive field "Cookie"`
+	zl.Info().Interface("user", c).Msg("login")                 // want `value of type credentials contains potentially sensitive field "PasswordHash"`
+	log.Printf("login %+v", c)                                  // want `value of type credentials contains potentially sensitive field "PasswordHash"`
+	logrus.Info("login", c)                                     // want `value of type credentials contains potentially sensitive field "PasswordHash"`
+
+	slog.Info("login", "user", ptrStringerUser{}) // want `value of type ptrStringerUser contains potentially sensitive field "Password"`
+	slog.Info("login", "user", badStringerUser{}) // want `value of type badStringerUser contains potentially sensitive field "Password"`
+	slog.Info("login", "user", badValuerUser{})   // want `value of type badValuerUser contains potentially sensitive field "Password"`
+
+	slog.Info("login", "users", users)
+	slog.Info("login", "user", valuerUser{})
+	z.Info("login", zap.Object("user", marshalerUser{}))
+	slog.Info("login", "user", &stringerUser{})
+	log.Printf("login %d", len(users))
+}
@@ -0,0 +1,58 @@
+package taint
+
+import (
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+
+	"github.com/rs/zerolog"
+)
+
+type config struct {
+	Token string
+	Host  string
+}
+
+func someFlows(cfg *config, zl zerolog.Logger) {
+	t := cfg.Token
+	msg := "got " + t
+	slog.Info(msg) // want `potentially sensitive data from field "Token" reaches logger`
+
+	// Прямое использование проверяют правила по именам
+	slog.Info("got " + cfg.Token) // want `potentially sensitive data "cfg.Token" is concatenated into log message`
+
+	// Функции-источники
+	key := os.Getenv("DB_SECRET")
+	slog.Info("connecting", "key", key)                    // want `potentially sensitive data from os.Getenv\("DB_SECRET"\) reaches logger`
+	slog.Info("connecting", "dsn", os.Getenv("API_TOKEN")) // want `potentially sensitive data from os.Getenv\("API_TOKEN"\) reaches logger`
+	host := os.Getenv("HOST")
+	slog.Info("connecting", "host", host)
+
+	// Производные значения
+	v := cfg.Token
+	attr := slog.String("v", v)
+	slog.Info("attr", attr) // want `potentially sensitive data from field "Token" reaches logger`
+	s := fmt.Sprintf("t=%s", v)
+	log.Print(s)                   // want `potentially sensitive data from field "Token" reaches logger`
+	zl.Info().Str("v", v).Msg("x") // want `potentially sensitive data from field "Token" reaches logger`
+	slog.Info("length", "n", len(v))
+	slog.Info("host", "host", cfg.Host)
+}
+
+func someBranches(cfg *config, verbose bool) {
+	out := "anonymous"
+	if verbose {
+		out = cfg.Token
+	}
+	slog.Info("user", "name", out) // want `potentially sensitive data from field "Token" reaches logger`
+}
+
+func readVault(path string) string { return path }
+
+func someCustomSources() {
+	dsn := readVault("secret/db")
+	slog.Info("connecting", "dsn", dsn) // want `potentially sensitive data from taint.readVault\("secret/db"\) reaches logger`
+	region := readVault("config/region")
+	slog.Info("connecting", "region", region)
+}
@@ -0,0 +1,50 @@
+// Package logging — обёртки над логгерами, которыми пользуются другие пакеты.
+package logging
+
+import (
+	"context"
+	stdlog "log"
+	"log/slog"
+
+	"github.com/rs/zerolog/log"
+	"go.uber.org/zap"
+)
+
+func LogErr(msg string, args ...any) { // want LogErr:"logWrapper\\(msg=0, kv=1\\)"
+	slog.Error(msg, args...)
+}
+
+func LogCtx(ctx context.Context, msg string) { // want LogCtx:"logWrapper\\(msg=1, kv=0\\)"
+	slog.InfoContext(ctx, msg)
+}
+
+// Warn — обёртка над обёрткой.
+func Warn(msg string, args ...any) { // want Warn:"logWrapper\\(msg=0, kv=1\\)"
+	LogErr(msg, args...)
+}
+
+func Event(msg string) { // want Event:"logWrapper\\(msg=0, kv=0\\)"
+	log.Info().Msg(msg)
+}
+
+func Logf(format string, args ...any) { // want Logf:"logWrapper\\(msg=0, kv=0, format\\)"
+	stdlog.Printf(format, args...)
+}
+
+type Logger struct {
+	z *zap.Logger
+}
+
+func (l *Logger) Infof(msg string, fields ...zap.Field) { // want Infof:"logWrapper\\(msg=0, kv=1\\)"
+	l.z.Info(msg, fields...)
+}
+
+// Prefixed изменяет сообщение — это не прямая передача.
+func Prefixed(msg string) {
+	slog.Info("prefix " + msg)
+}
+
+// Unrelated не передаёт параметр в логгер.
+func Unrelated(msg string) {
+	slog.Info("hello")
+}
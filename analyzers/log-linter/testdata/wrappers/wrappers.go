@@ -0,0 +1,27 @@
+package wrappers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"testdata/wrappers/logging"
+)
+
+func someWrappers(ctx context.Context, l *logging.Logger) {
+	token := "abracadabra"
+
+	logging.LogErr("Hello")                     // want "log messages must start with lowercase letter"
+	logging.LogErr("hello", "password", token)  // want "potentially sensitive key \"password\" is passed to logger"
+	logging.LogCtx(ctx, "hello!")               // want "log messages must not contains any special symbols"
+	logging.Warn("привeт")                      // want "log messages must only contains latin letters"
+	logging.Event("hello" + token)              // want "potentially sensitive data \"token\" is concatenated into log message"
+	l.Infof("Hello", zap.String("user", "bob")) // want "log messages must start with lowercase letter"
+
+	logging.Logf("user %s not found", "bob")
+	logging.Logf("User %s not found", "bob") // want "log messages must start with lowercase letter"
+
+	logging.Prefixed("Hello")
+	logging.Unrelated("Hello")
+	logging.LogErr("hello")
+}
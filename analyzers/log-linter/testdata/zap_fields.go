@@ -0,0 +1,46 @@
+package testdata
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+func someZapFields(logger *zap.Logger, sugar *zap.SugaredLogger) {
+	user := "alice"
+	pw := "123123"
+	password := "123123"
+	err := errors.New("boom")
+
+	// Конструкторы zap.Field
+	logger.Info("login", zap.String("password", pw))                      // want "potentially sensitive key \"password\" is passed to logger"
+	logger.Info("login", zap.String("user", password))                    // want "potentially sensitive data \"password\" is passed to logger"
+	logger.Info("login", zap.Int("attempt", 1), zap.Any("api_token", pw)) // want "potentially sensitive key \"api_token\" is passed to logger"
+	logger.Info("login", zap.NamedError("auth_error", err))               // want "potentially sensitive key \"auth_error\" is passed to logger"
+	logger.Info("login", zap.String("user", user), zap.Error(err))
+
+	// Вложенные поля
+	logger.Info("login", zap.Dict("req", zap.String("secret", pw))) // want "potentially sensitive key \"secret\" is passed to logger"
+	logger.Info("login", zap.Dict("credentials", zap.Int("n", 1)))  // want "potentially sensitive key \"credentials\" is passed to logger"
+
+	// Поля, прикреплённые к логгеру заранее
+	logger.With(zap.String("token", pw)).Info("login") // want "potentially sensitive key \"token\" is passed to logger"
+	logger.WithLazy(zap.String("user", password))      // want "potentially sensitive data \"password\" is passed to logger"
+	sugar.With("password", pw).Info("login")           // want "potentially sensitive key \"password\" is passed to logger"
+	sugar.With(zap.String("secret", pw)).Info("login") // want "potentially sensitive key \"secret\" is passed to logger"
+	slog.With("pw", password).Info("login")            // want "potentially sensitive data \"password\" is passed to logger"
+	slog.Default().With("user", user).Info("login")
+}
+
+func someLogrusFields() {
+	pw := "123123"
+
+	logrus.WithField("password", pw).Info("login") // want "potentially sensitive key \"password\" is passed to logger"
+	logrus.WithFields(logrus.Fields{
+		"user":   "alice",
+		"secret": pw, // want "potentially sensitive key \"secret\" is passed to logger"
+	}).Info("login")
+	logrus.WithField("user", "alice").Info("login")
+}
@@ -0,0 +1,35 @@
+package testdata
+
+import (
+	"go.uber.org/zap"
+)
+
+func someZapSugar(sugar *zap.SugaredLogger) {
+	name := "alice"
+	pw := "123123"
+
+	// Варианты *f, *w и *ln
+	sugar.Infof("User")    // want "log messages must start with lowercase letter"
+	sugar.Infow("Started") // want "log messages must start with lowercase letter"
+	sugar.Infoln("Hello")  // want "log messages must start with lowercase letter"
+	sugar.Debugw("привeт") // want "log messages must only contains latin letters"
+	sugar.Errorf("hello!") // want "log messages must not contains any special symbols"
+	sugar.DPanicw("hello", "user", name)
+	sugar.Panicf("Hello")              // want "log messages must start with lowercase letter"
+	sugar.Logw(zap.InfoLevel, "Hello") // want "log messages must start with lowercase letter"
+
+	// Хвост ключ-значение у *w
+	sugar.Infow("started", "password", pw)                         // want "potentially sensitive key \"password\" is passed to logger"
+	sugar.Warnw("started", "user", name, "api_token", pw)          // want "potentially sensitive key \"api_token\" is passed to logger"
+	sugar.Warnw("started", zap.String("user", name), "secret", pw) // want "potentially sensitive key \"secret\" is passed to logger"
+	sugar.Infow("started", "user", "password")
+
+	// DPanic и Panic у zap.Logger
+	logger := sugar.Desugar()
+	logger.DPanic("Hello") // want "log messages must start with lowercase letter"
+	logger.Panic("hello")
+
+	// Не методы логирования
+	sugar.With("Key", "Value")
+	_ = sugar.Sync()
+}
@@ -0,0 +1,47 @@
+package testdata
+
+import (
+	"errors"
+	"os"
+
+	"github.com/rs/zerolog"
+	zlog "github.com/rs/zerolog/log"
+)
+
+func someZerolog() {
+	logger := zerolog.New(os.Stderr)
+	err := errors.New("boom")
+	name := "alice"
+
+	// Сообщение в завершающем Msg
+	zlog.Info().Msg("Hello")                   // want "log messages must start with lowercase letter"
+	zlog.Info().Str("user", name).Msg("Hello") // want "log messages must start with lowercase letter"
+	zlog.Debug().Msg("hello")
+	logger.Warn().Int("attempt", 1).Msg("привeт")     // want "log messages must only contains latin letters"
+	logger.Err(err).Msg("hello!")                     // want "log messages must not contains any special symbols"
+	logger.WithLevel(zerolog.InfoLevel).Msgf("Hello") // want "log messages must start with lowercase letter"
+	zlog.Error().Err(err).Str("user", name).Msg("hello")
+
+	// Ключи полей по ходу цепочки
+	token := "abracadabra"
+	zlog.Info().Str("password", name).Msg("hello")                              // want "potentially sensitive key \"password\" is passed to logger"
+	logger.Info().Str("user", name).Str("api_token", token).Send()              // want "potentially sensitive key \"api_token\" is passed to logger"
+	logger.Info().Str("user", name).Dict("secret", zerolog.Dict()).Msg("hello") // want "potentially sensitive key \"secret\" is passed to logger"
+	zlog.Info().Str("user", token).Msg("hello")                                 // want "potentially sensitive data \"token\" is passed to logger"
+	zlog.Warn().Msg("hello" + token)                                            // want "potentially sensitive data \"token\" is concatenated into log message"
+	zlog.Info().Str("user", name).Send()
+
+	// Print и Printf логгера и пакета zerolog/log
+	logger.Print("Hello")                  // want "log messages must start with lowercase letter"
+	logger.Printf("hello %s!", name)       // want "log messages must not contains any special symbols"
+	zlog.Print("hello " + token)           // want "potentially sensitive data \"token\" is concatenated into log message"
+	zlog.Printf("user %s not found", 1, 2) // want `zlog.Printf call needs 1 arg but has 2 args`
+
+	// Цепочки без начала события не проверяются
+	zerolog.Dict().Str("k", "v")
+
+	// Событие в переменной не проверяется: начало цепочки не разрешается
+	// через присваивание.
+	ev := zlog.Info()
+	ev.Str("password", name).Msg("Hello")
+}
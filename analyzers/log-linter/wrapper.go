@@ -0,0 +1,114 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// wrapperFact помечает функцию-обёртку над логгером, например
+//
+//	func logErr(msg string, args ...any) { slog.Error(msg, args...) }
+//
+// Строковый параметр обёртки напрямую попадает в сообщение логгера,
+// а вариативный, если он есть, — в пары ключ-значение. Вызовы обёртки,
+// в том числе из других пакетов, проверяются как вызовы самого логгера.
+type wrapperFact struct {
+	// Msg — индекс параметра-сообщения.
+	Msg int
+	// KV — индекс вариативного параметра с парами ключ-значение;
+	// 0 означает, что их нет.
+	KV int
+	// Format — сообщение обёртки является строкой формата.
+	Format bool
+}
+
+func (*wrapperFact) AFact() {}
+
+func (f *wrapperFact) String() string {
+	if f.Format {
+		return fmt.Sprintf("logWrapper(msg=%d, kv=%d, format)", f.Msg, f.KV)
+	}
+	return fmt.Sprintf("logWrapper(msg=%d, kv=%d)", f.Msg, f.KV)
+}
+
+// exportWrapperFacts находит в пакете обёртки над логгерами и помечает
+// их фактами. Обёртки над обёртками из того же пакета находятся
+// повторными проходами, пока находятся новые.
+func exportWrapperFacts(pass *analysis.Pass, insp *inspector.Inspector, reg registry) {
+	nodeFilter := []ast.Node{
+		(*ast.FuncDecl)(nil),
+	}
+	for changed := true; changed; {
+		changed = false
+		insp.Preorder(nodeFilter, func(n ast.Node) {
+			decl, ok := n.(*ast.FuncDecl)
+			if !ok || decl.Body == nil {
+				return
+			}
+			fn, ok := pass.TypesInfo.Defs[decl.Name].(*types.Func)
+			if !ok || pass.ImportObjectFact(fn, new(wrapperFact)) {
+				return
+			}
+			if fact, ok := findWrapper(pass, reg, fn, decl.Body); ok {
+				pass.ExportObjectFact(fn, fact)
+				changed = true
+			}
+		})
+	}
+}
+
+// findWrapper ищет в теле функции вызов логгера, сообщением которого
+// является строковый параметр функции.
+func findWrapper(pass *analysis.Pass, reg registry, fn *types.Func, body *ast.BlockStmt) (*wrapperFact, bool) {
+	sig := fn.Type().(*types.Signature)
+	params := sig.Params()
+	paramIndex := func(expr ast.Expr) int {
+		ident, ok := ast.Unparen(expr).(*ast.Ident)
+		if !ok {
+			return -1
+		}
+		obj := pass.TypesInfo.Uses[ident]
+		for i := range params.Len() {
+			if params.At(i) == obj {
+				return i
+			}
+		}
+		return -1
+	}
+
+	var fact *wrapperFact
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || fact != nil {
+			return fact == nil
+		}
+
+		var msg, kv ast.Expr
+		var format bool
+		if ev, ok := parseZerologChain(pass, call); ok && ev.msg != nil {
+			msg, format = ev.msg, ev.format
+		} else if lf, ok := lookupCall(pass, reg, call); ok && lf.hasMsg() && len(call.Args) > lf.msg {
+			msg, format = call.Args[lf.msg], lf.format
+			if lf.hasKV() && lf.kv == len(call.Args)-1 && call.Ellipsis.IsValid() {
+				kv = call.Args[lf.kv]
+			}
+		} else {
+			return true
+		}
+
+		i := paramIndex(msg)
+		if i < 0 || !isStringType(params.At(i).Type()) {
+			return true
+		}
+		fact = &wrapperFact{Msg: i, Format: format}
+		if j := paramIndex(kv); sig.Variadic() && j == params.Len()-1 {
+			fact.KV = j
+		}
+		return false
+	})
+	return fact, fact != nil
+}
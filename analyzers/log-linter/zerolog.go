@@ -0,0 +1,123 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	zerologPath    = "github.com/rs/zerolog"
+	zerologLogPath = "github.com/rs/zerolog/log"
+)
+
+// zerologLevels — методы zerolog.Logger и функции пакета zerolog/log,
+// с которых начинается событие.
+var zerologLevels = methodSet([]string{
+	"Trace", "Debug", "Info", "Warn", "Error", "Err", "Fatal", "Panic", "WithLevel", "Log",
+})
+
+// zerologMessages — завершающие методы zerolog.Event, принимающие сообщение.
+var zerologMessages = methodSet([]string{"Msg", "Msgf"})
+
+// zerologSend — завершающий метод zerolog.Event без сообщения.
+const zerologSend = "Send"
+
+// zerologEvent — разобранная цепочка вызовов zerolog.
+type zerologEvent struct {
+	// call — завершающий вызов Msg/Msgf/Send.
+	call *ast.CallExpr
+	// msg — аргумент завершающего Msg/Msgf; nil для Send.
+	msg ast.Expr
+	// format — сообщение является строкой формата (Msgf).
+	format bool
+	// fields — поля, добавленные по ходу цепочки.
+	fields []field
+	// calls — вызовы методов, добавивших поля.
+	calls []*ast.CallExpr
+}
+
+// parseZerologChain разбирает цепочку вида
+//
+//	log.Info().Str("k", v).Msg("hello")
+//
+// от завершающего вызова Msg/Msgf/Send к началу события (Info(), Err(err),
+// WithLevel(...) и т.д.). У цепочки, завершённой Send, нет сообщения,
+// но ключи её полей проверяются так же. Если начало события не найдено,
+// цепочка не считается логированием — в том числе когда событие сохранено
+// в переменную и завершено отдельным выражением.
+func parseZerologChain(pass *analysis.Pass, call *ast.CallExpr) (*zerologEvent, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, false
+	}
+	switch name := sel.Sel.Name; {
+	case zerologMessages[name] && len(call.Args) > 0:
+	case name == zerologSend && len(call.Args) == 0:
+	default:
+		return nil, false
+	}
+	if !isZerologEventMethod(calledFunc(pass, sel)) {
+		return nil, false
+	}
+
+	ev := &zerologEvent{
+		call:   call,
+		format: sel.Sel.Name == "Msgf",
+	}
+	if len(call.Args) > 0 {
+		ev.msg = call.Args[0]
+	}
+	x := sel.X
+	for {
+		c, ok := ast.Unparen(x).(*ast.CallExpr)
+		if !ok {
+			return nil, false
+		}
+		s, ok := c.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return nil, false
+		}
+
+		fn := calledFunc(pass, s)
+		switch {
+		case isZerologEventMethod(fn):
+			if hasKeyParam(fn) && len(c.Args) > 0 {
+				ev.fields = append(ev.fields, newField(c.Args, nil))
+				ev.calls = append(ev.calls, c)
+			}
+			x = s.X
+		case isZerologOrigin(fn):
+			return ev, true
+		default:
+			return nil, false
+		}
+	}
+}
+
+// isZerologEventMethod сообщает, является ли fn методом *zerolog.Event.
+func isZerologEventMethod(fn *types.Func) bool {
+	pkg, recv := funcReceiver(fn)
+	return pkg == zerologPath && recv == "Event"
+}
+
+// isZerologOrigin сообщает, начинает ли fn событие zerolog:
+// метод zerolog.Logger или функция пакета zerolog/log.
+func isZerologOrigin(fn *types.Func) bool {
+	if fn == nil || !zerologLevels[fn.Name()] {
+		return false
+	}
+	pkg, recv := funcReceiver(fn)
+	return (pkg == zerologPath && recv == "Logger") ||
+		(pkg == zerologLogPath && recv == "")
+}
+
+// methodSet строит множество имён методов.
+func methodSet(names []string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[name] = true
+	}
+	return set
+}
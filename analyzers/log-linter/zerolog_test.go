@@ -0,0 +1,31 @@
+package analyzer
+
+import (
+	"go/types"
+	"testing"
+)
+
+// ---------- TestIsZerologOrigin ----------
+
+func TestIsZerologOrigin(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   *types.Func
+		want bool
+	}{
+		{"Logger.Info", makeMethod(zerologPath, "Logger", "Info"), true},
+		{"Logger.WithLevel", makeMethod(zerologPath, "Logger", "WithLevel"), true},
+		{"Logger.With is not origin", makeMethod(zerologPath, "Logger", "With"), false},
+		{"Event.Err is not origin", makeMethod(zerologPath, "Event", "Err"), false},
+		{"foreign Logger.Info", makeMethod("example.com/log", "Logger", "Info"), false},
+		{"nil", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isZerologOrigin(tt.fn); got != tt.want {
+				t.Errorf("isZerologOrigin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,9 +2,9 @@ package main
 
 import (
 	analyzer "github.com/prr133f/go-log-linter/analyzers/log-linter"
-	"golang.org/x/tools/go/analysis/singlechecker"
+	"golang.org/x/tools/go/analysis/multichecker"
 )
 
 func main() {
-	singlechecker.Main(analyzer.New())
+	multichecker.Main(analyzer.NewAnalyzers()...)
 }
@@ -11,7 +11,59 @@ func init() {
 }
 
 type Settings struct {
-	SensitivePatterns []string `json:"sensitivePatterns"`
+	SensitivePatterns  []string                `json:"sensitivePatterns"`
+	PatternsMode       string                  `json:"patternsMode"`
+	ExcludePatterns    []string                `json:"excludePatterns"`
+	AllowPatterns      []string                `json:"allowPatterns"`
+	SensitiveTag       string                  `json:"sensitiveTag"`
+	Loggers            []LoggerSettings        `json:"loggers"`
+	Taint              bool                    `json:"taint"`
+	TaintSources       []TaintSettings         `json:"taintSources"`
+	PII                bool                    `json:"pii"`
+	PIIPatterns        []string                `json:"piiPatterns"`
+	AllowedPunctuation string                  `json:"allowedPunctuation"`
+	AllowedScripts     []string                `json:"allowedScripts"`
+	MessagePattern     string                  `json:"messagePattern"`
+	Rules              map[string]RuleSettings `json:"rules"`
+	Overrides          []OverrideSettings      `json:"overrides"`
+	Debug              bool                    `json:"debug"`
+}
+
+// LoggerSettings описывает дополнительный логгер, см. analyzer.LoggerSpec.
+type LoggerSettings struct {
+	Package       string   `json:"package"`
+	Receiver      string   `json:"receiver"`
+	Methods       []string `json:"methods"`
+	MessageIndex  int      `json:"messageIndex"`
+	KeyValueIndex int      `json:"keyValueIndex"`
+	Format        bool     `json:"format"`
+}
+
+// TaintSettings описывает функцию-источник секретных данных,
+// см. analyzer.TaintSource.
+type TaintSettings struct {
+	Package   string   `json:"package"`
+	Receiver  string   `json:"receiver"`
+	Functions []string `json:"functions"`
+	Args      []string `json:"args"`
+}
+
+// RuleSettings описывает настройки правила, см. analyzer.RuleConfig.
+type RuleSettings struct {
+	Enabled  *bool  `json:"enabled"`
+	Severity string `json:"severity"`
+}
+
+// OverrideSettings описывает настройки для части пакетов и файлов,
+// см. analyzer.Override.
+type OverrideSettings struct {
+	Packages           []string                `json:"packages"`
+	Files              []string                `json:"files"`
+	Rules              map[string]RuleSettings `json:"rules"`
+	SensitivePatterns  []string                `json:"sensitivePatterns"`
+	AllowPatterns      []string                `json:"allowPatterns"`
+	AllowedPunctuation string                  `json:"allowedPunctuation"`
+	AllowedScripts     []string                `json:"allowedScripts"`
 }
 
 func New(settings any) (register.LinterPlugin, error) {
@@ -24,7 +76,12 @@ func New(settings any) (register.LinterPlugin, error) {
 		}
 	}
 
-	return LogLinterPlugin{settings: s}, nil
+	p := LogLinterPlugin{settings: s}
+	if err := p.config().Validate(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
 }
 
 type LogLinterPlugin struct {
@@ -32,13 +89,81 @@ type LogLinterPlugin struct {
 }
 
 func (p LogLinterPlugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
-	return []*analysis.Analyzer{
-		analyzer.New(analyzer.Config{
-			SensitivePatterns: p.settings.SensitivePatterns,
-		}),
-	}, nil
+	return analyzer.NewAnalyzers(p.config()), nil
 }
 
 func (p LogLinterPlugin) GetLoadMode() string {
 	return register.LoadModeTypesInfo
 }
+
+// config переводит настройки плагина в конфигурацию анализатора.
+func (p LogLinterPlugin) config() analyzer.Config {
+	loggers := make([]analyzer.LoggerSpec, 0, len(p.settings.Loggers))
+	for _, l := range p.settings.Loggers {
+		loggers = append(loggers, analyzer.LoggerSpec{
+			Package:       l.Package,
+			Receiver:      l.Receiver,
+			Methods:       l.Methods,
+			MessageIndex:  l.MessageIndex,
+			KeyValueIndex: l.KeyValueIndex,
+			Format:        l.Format,
+		})
+	}
+
+	sources := make([]analyzer.TaintSource, 0, len(p.settings.TaintSources))
+	for _, s := range p.settings.TaintSources {
+		sources = append(sources, analyzer.TaintSource{
+			Package:   s.Package,
+			Receiver:  s.Receiver,
+			Functions: s.Functions,
+			Args:      s.Args,
+		})
+	}
+
+	overrides := make([]analyzer.Override, 0, len(p.settings.Overrides))
+	for _, o := range p.settings.Overrides {
+		overrides = append(overrides, analyzer.Override{
+			Packages:           o.Packages,
+			Files:              o.Files,
+			Rules:              ruleConfigs(o.Rules),
+			SensitivePatterns:  o.SensitivePatterns,
+			AllowPatterns:      o.AllowPatterns,
+			AllowedPunctuation: o.AllowedPunctuation,
+			AllowedScripts:     o.AllowedScripts,
+		})
+	}
+
+	return analyzer.Config{
+		SensitivePatterns:  p.settings.SensitivePatterns,
+		PatternsMode:       analyzer.PatternsMode(p.settings.PatternsMode),
+		ExcludePatterns:    p.settings.ExcludePatterns,
+		AllowPatterns:      p.settings.AllowPatterns,
+		SensitiveTag:       p.settings.SensitiveTag,
+		Loggers:            loggers,
+		Taint:              p.settings.Taint,
+		TaintSources:       sources,
+		PII:                p.settings.PII,
+		PIIPatterns:        p.settings.PIIPatterns,
+		AllowedPunctuation: p.settings.AllowedPunctuation,
+		AllowedScripts:     p.settings.AllowedScripts,
+		MessagePattern:     p.settings.MessagePattern,
+		Rules:              ruleConfigs(p.settings.Rules),
+		Overrides:          overrides,
+		Debug:              p.settings.Debug,
+	}
+}
+
+// ruleConfigs переводит настройки правил в конфигурацию анализатора.
+func ruleConfigs(settings map[string]RuleSettings) map[string]analyzer.RuleConfig {
+	if len(settings) == 0 {
+		return nil
+	}
+	rules := make(map[string]analyzer.RuleConfig, len(settings))
+	for id, r := range settings {
+		rules[id] = analyzer.RuleConfig{
+			Enabled:  r.Enabled,
+			Severity: analyzer.Severity(r.Severity),
+		}
+	}
+	return rules
+}